@@ -5,11 +5,13 @@ import (
 	"github.com/forbole/juno/v5/cmd"
 	initcmd "github.com/forbole/juno/v5/cmd/init"
 	parsetypes "github.com/forbole/juno/v5/cmd/parse/types"
-	startcmd "github.com/forbole/juno/v5/cmd/start"
+	"github.com/forbole/juno/v5/logging"
 	"github.com/forbole/juno/v5/modules/messages"
 
 	migratecmd "github.com/forbole/callisto/v4/cmd/migrate"
 	parsecmd "github.com/forbole/callisto/v4/cmd/parse"
+	partitionscmd "github.com/forbole/callisto/v4/cmd/partitions"
+	startcmd "github.com/forbole/callisto/v4/cmd/start"
 
 	"github.com/forbole/callisto/v4/types/config"
 
@@ -17,6 +19,7 @@ import (
 
 	"github.com/forbole/callisto/v4/database"
 	"github.com/forbole/callisto/v4/modules"
+	blockbatch "github.com/forbole/callisto/v4/modules/block_batch"
 )
 
 func main() {
@@ -26,7 +29,8 @@ func main() {
 	parseCfg := parsetypes.NewConfig().
 		WithDBBuilder(database.Builder).
 		WithEncodingConfigBuilder(config.MakeEncodingConfig(getBasicManagers())).
-		WithRegistrar(modules.NewRegistrar(getAddressesParser()))
+		WithRegistrar(modules.NewRegistrar(getAddressesParser())).
+		WithLogger(blockbatch.NewLogger(logging.DefaultLogger()))
 
 	cfg := cmd.NewConfig("callisto").
 		WithInitConfig(initCfg).
@@ -39,6 +43,7 @@ func main() {
 		cmd.VersionCmd(),
 		initcmd.NewInitCmd(cfg.GetInitConfig()),
 		parsecmd.NewParseCmd(cfg.GetParseConfig()),
+		partitionscmd.NewPartitionsCmd(cfg.GetParseConfig()),
 		migratecmd.NewMigrateCmd(cfg.GetName(), cfg.GetParseConfig()),
 		startcmd.NewStartCmd(cfg.GetParseConfig()),
 	)
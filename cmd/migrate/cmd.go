@@ -30,13 +30,15 @@ func getVersions() []string {
 
 // NewMigrateCmd returns the Cobra command allowing to migrate config and tables to v3 version
 func NewMigrateCmd(appName string, parseConfig *parsecmdtypes.Config) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "migrate [to-version]",
 		Short: "Perform the migrations from the current version to the specified one",
 		Long: `Migrates all the necessary things (config file, database, etc) from the current version to the new one.
 Note that migrations must be performed in order: to migrate from vX to vX+2 you need to do vX -> vX+1 and then vX+1 -> vX+2. 
+
+The database schema migrations are instead applied, reverted and listed using the up, down and status subcommands.
 `,
-		Example: fmt.Sprintf("%s migrate v3", appName),
+		Example: fmt.Sprintf("%s migrate v3\n%s migrate up\n%s migrate status", appName, appName, appName),
 		Args:    cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SetOut(os.Stdout)
@@ -57,4 +59,12 @@ Note that migrations must be performed in order: to migrate from vX to vX+2 you
 			return migrator(parseConfig)
 		},
 	}
+
+	cmd.AddCommand(
+		downCmd(parseConfig),
+		statusCmd(parseConfig),
+		upCmd(parseConfig),
+	)
+
+	return cmd
 }
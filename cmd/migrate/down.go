@@ -0,0 +1,58 @@
+package migrate
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+const (
+	flagSteps = "steps"
+)
+
+// downCmd returns the Cobra command allowing to revert the latest applied schema migrations
+func downCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "down",
+		Short:   "Revert the latest applied schema migrations",
+		Args:    cobra.NoArgs,
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps, _ := cmd.Flags().GetInt(flagSteps)
+			if steps <= 0 {
+				return fmt.Errorf("the number of migrations to revert must be greater than 0")
+			}
+
+			db, err := getDatabase(parseConfig)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			migrations, err := database.GetMigrations()
+			if err != nil {
+				return err
+			}
+
+			reverted, err := db.MigrateDown(migrations, steps)
+			if err != nil {
+				return err
+			}
+
+			for _, migration := range reverted {
+				log.Info().Int64("version", migration.Version).Str("name", migration.Name).
+					Msg("reverted schema migration")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagSteps, 1, "Number of latest applied migrations to revert")
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// statusCmd returns the Cobra command allowing to list the schema migrations along with their status
+func statusCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:     "status",
+		Short:   "List the schema migrations telling which ones have been applied",
+		Args:    cobra.NoArgs,
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := getDatabase(parseConfig)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			migrations, err := database.GetMigrations()
+			if err != nil {
+				return err
+			}
+
+			applied, err := db.GetAppliedMigrations()
+			if err != nil {
+				return err
+			}
+
+			appliedAt := map[int64]time.Time{}
+			for _, row := range applied {
+				appliedAt[row.Version] = row.AppliedAt
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(writer, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+
+			known := map[int64]bool{}
+			for _, migration := range migrations {
+				known[migration.Version] = true
+
+				status, timestamp := "pending", "-"
+				if at, ok := appliedAt[migration.Version]; ok {
+					status, timestamp = "applied", at.Format(time.RFC3339)
+				}
+				fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", migration.Version, migration.Name, status, timestamp)
+			}
+
+			// Migrations applied by a newer version of the binary
+			for _, row := range applied {
+				if !known[row.Version] {
+					fmt.Fprintf(writer, "%d\t%s\t%s\t%s\n", row.Version, row.Name, "unknown", row.AppliedAt.Format(time.RFC3339))
+				}
+			}
+
+			return writer.Flush()
+		},
+	}
+}
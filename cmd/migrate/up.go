@@ -0,0 +1,19 @@
+package migrate
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// upCmd returns the Cobra command allowing to apply all the pending schema migrations
+func upCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:     "up",
+		Short:   "Apply all the pending schema migrations",
+		Args:    cobra.NoArgs,
+		PreRunE: parsecmdtypes.ReadConfigPreRunE(parseConfig),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunMigrationsUp(parseConfig)
+		},
+	}
+}
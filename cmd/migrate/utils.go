@@ -0,0 +1,49 @@
+package migrate
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	junodb "github.com/forbole/juno/v5/database"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// getDatabase builds the database instance reading the configuration loaded inside config.Cfg
+func getDatabase(parseConfig *parsecmdtypes.Config) (*database.Db, error) {
+	encodingConfig := parseConfig.GetEncodingConfigBuilder()()
+	databaseCtx := junodb.NewContext(config.Cfg.Database, encodingConfig, parseConfig.GetLogger())
+	db, err := database.Builder(databaseCtx)
+	if err != nil {
+		return nil, fmt.Errorf("error while building the db: %s", err)
+	}
+
+	return database.Cast(db), nil
+}
+
+// RunMigrationsUp applies all the pending schema migrations to the configured database
+func RunMigrationsUp(parseConfig *parsecmdtypes.Config) error {
+	db, err := getDatabase(parseConfig)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrations, err := database.GetMigrations()
+	if err != nil {
+		return err
+	}
+
+	applied, err := db.MigrateUp(migrations)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range applied {
+		log.Info().Int64("version", migration.Version).Str("name", migration.Name).Msg("applied schema migration")
+	}
+
+	return nil
+}
@@ -33,7 +33,7 @@ func supplyCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 			db := database.Cast(parseCtx.Database)
 
 			// Build bank module
-			bankModule := bank.NewModule(nil, sources.BankSource, parseCtx.EncodingConfig.Codec, db)
+			bankModule := bank.NewModule(config.Cfg, nil, sources.BankSource, parseCtx.EncodingConfig.Codec, db)
 
 			err = bankModule.UpdateSupply()
 			if err != nil {
@@ -0,0 +1,71 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/consensus"
+	"github.com/forbole/callisto/v4/modules/staking"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+)
+
+const (
+	flagSince = "since"
+)
+
+// blockTimeCmd returns a Cobra command that allows to backfill the block time series
+// using the blocks already stored inside the database
+func blockTimeCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-time",
+		Short: "Compute the block time series from the stored blocks",
+		Long: fmt.Sprintf(`Compute the per minute, hour and day block time buckets from the blocks stored inside the database. 
+By default, the computation resumes from the latest stored buckets. 
+You can recompute the buckets starting from a given date (YYYY-MM-DD) by using the %s flag.
+`, flagSince),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the consensus module
+			stakingModule := staking.NewModule(sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
+			consensusModule := consensus.NewModule(parseCtx.Node, stakingModule, db)
+
+			var since *time.Time
+			sinceValue, _ := cmd.Flags().GetString(flagSince)
+			if sinceValue != "" {
+				sinceDate, err := time.Parse(time.DateOnly, sinceValue)
+				if err != nil {
+					return fmt.Errorf("invalid %s date: %s", flagSince, err)
+				}
+				since = &sinceDate
+			}
+
+			err = consensusModule.UpdateBlockTimeSeries(since)
+			if err != nil {
+				return fmt.Errorf("error while updating block time series: %s", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSince, "", "Date (YYYY-MM-DD) from which to recompute the block time buckets. If empty, the computation resumes from the latest stored buckets")
+
+	return cmd
+}
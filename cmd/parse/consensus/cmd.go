@@ -0,0 +1,21 @@
+package consensus
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewConsensusCmd returns the Cobra command allowing to fix various things related to the consensus
+func NewConsensusCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus",
+		Short: "Fix things related to the consensus",
+	}
+
+	cmd.AddCommand(
+		blockTimeCmd(parseConfig),
+		proposerStatsCmd(parseConfig),
+	)
+
+	return cmd
+}
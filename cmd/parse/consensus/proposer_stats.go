@@ -0,0 +1,98 @@
+package consensus
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/consensus"
+	"github.com/forbole/callisto/v4/modules/staking"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+)
+
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+)
+
+// proposerStatsCmd returns a Cobra command that allows to backfill the daily proposer statistics
+// using the blocks already stored inside the database
+func proposerStatsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-stats",
+		Short: "Backfill the daily block production statistics of the validators from the stored blocks",
+		Long: fmt.Sprintf(`Include all the blocks stored inside the database in the specified range inside the daily 
+proposer statistics. You can specify a custom blocks range by using the %s and %s flags. 
+Blocks that have already been included are skipped, so this command can be safely run more than once.
+`, flagStart, flagEnd),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the consensus module
+			stakingModule := staking.NewModule(sources.StakingSource, parseCtx.EncodingConfig.Codec, db)
+			consensusModule := consensus.NewModule(parseCtx.Node, stakingModule, db)
+
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+
+			if start == 0 {
+				start = config.Cfg.Parser.StartHeight
+			}
+
+			if end == 0 {
+				end, err = db.GetLastBlockHeight()
+				if err != nil {
+					return fmt.Errorf("error while getting last block height: %s", err)
+				}
+			}
+
+			log.Info().Int64("start height", start).Int64("end height", end).
+				Msg("backfilling proposer stats")
+			for height := start; height <= end; height++ {
+				block, err := db.GetBlock(height)
+				if err != nil {
+					return err
+				}
+
+				// Skip the blocks that are not stored
+				if block == nil {
+					continue
+				}
+
+				vals, err := parseCtx.Node.Validators(height)
+				if err != nil {
+					return fmt.Errorf("error while getting validators at height %d: %s", height, err)
+				}
+
+				err = consensusModule.UpdateProposerStats(
+					block.Height, block.Timestamp, block.ProposerAddress.String, block.TxNum, block.TotalGas, vals,
+				)
+				if err != nil {
+					return fmt.Errorf("error while updating proposer stats at height %d: %s", height, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start backfilling. If 0, the start height inside the config will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to stop backfilling. If 0, the latest height stored inside the database will be used instead")
+
+	return cmd
+}
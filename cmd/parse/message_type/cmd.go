@@ -0,0 +1,20 @@
+package message_type
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewMessageTypeCmd returns the Cobra command that allows to fix all the things related to the message types
+func NewMessageTypeCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-type",
+		Short: "Fix things related to the message types",
+	}
+
+	cmd.AddCommand(
+		statsCmd(parseConfig),
+	)
+
+	return cmd
+}
@@ -0,0 +1,96 @@
+package message_type
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/modules/messages"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	messagetype "github.com/forbole/callisto/v4/modules/message_type"
+)
+
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+)
+
+// statsCmd returns a Cobra command that allows to backfill the message types and their usage statistics
+// using the blocks already stored inside the database
+func statsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Backfill the message types and their usage statistics from the stored blocks",
+		Long: fmt.Sprintf(`Include the transactions of all the blocks stored inside the database in the specified range 
+inside the message types and their usage statistics. You can specify a custom blocks range by using the %s and %s flags. 
+Blocks that have already been included are skipped, so this command can be safely run more than once.
+The usage statistics are computed while handling the blocks, so this command should be run after re-parsing 
+transactions without their blocks (eg. using the parse transactions command) to include them.
+`, flagStart, flagEnd),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the message type module
+			messageTypeModule := messagetype.NewModule(messages.CosmosMessageAddressesParser, parseCtx.EncodingConfig.Codec, db)
+
+			start, _ := cmd.Flags().GetInt64(flagStart)
+			end, _ := cmd.Flags().GetInt64(flagEnd)
+
+			if start == 0 {
+				start = config.Cfg.Parser.StartHeight
+			}
+
+			if end == 0 {
+				end, err = db.GetLastBlockHeight()
+				if err != nil {
+					return fmt.Errorf("error while getting last block height: %s", err)
+				}
+			}
+
+			log.Info().Int64("start height", start).Int64("end height", end).
+				Msg("backfilling message types")
+			for height := start; height <= end; height++ {
+				stored, err := db.GetBlock(height)
+				if err != nil {
+					return err
+				}
+
+				// Skip the blocks that are not stored or that have no transactions
+				if stored == nil || stored.TxNum == 0 {
+					continue
+				}
+
+				block, err := parseCtx.Node.Block(height)
+				if err != nil {
+					return fmt.Errorf("error while getting block at height %d: %s", height, err)
+				}
+
+				txs, err := parseCtx.Node.Txs(block)
+				if err != nil {
+					return fmt.Errorf("error while getting transactions at height %d: %s", height, err)
+				}
+
+				err = messageTypeModule.HandleBlock(block, nil, txs, nil)
+				if err != nil {
+					return fmt.Errorf("error while updating message types at height %d: %s", height, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagStart, 0, "Height from which to start backfilling. If 0, the start height inside the config will be used instead")
+	cmd.Flags().Int64(flagEnd, 0, "Height at which to stop backfilling. If 0, the latest height stored inside the database will be used instead")
+
+	return cmd
+}
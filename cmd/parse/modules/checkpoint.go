@@ -0,0 +1,85 @@
+package modules
+
+import (
+	"sync"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// checkpointInterval represents the number of heights after which the backfill checkpoint is stored
+const checkpointInterval = 100
+
+// checkpointTracker keeps track of the heights that have been replayed by concurrent workers,
+// storing as checkpoint the latest height up to which all the heights have been replayed
+type checkpointTracker struct {
+	mu sync.Mutex
+
+	db          *database.Db
+	modules     string
+	startHeight int64
+
+	lastHeight  int64
+	savedHeight int64
+	done        map[int64]bool
+}
+
+// newCheckpointTracker returns a new checkpointTracker instance for the backfill of the given modules
+// started at the given height, having replayed all the heights up to the given one
+func newCheckpointTracker(db *database.Db, modules string, startHeight int64, lastHeight int64) *checkpointTracker {
+	return &checkpointTracker{
+		db:          db,
+		modules:     modules,
+		startHeight: startHeight,
+		lastHeight:  lastHeight,
+		savedHeight: lastHeight,
+		done:        map[int64]bool{},
+	}
+}
+
+// markDone marks the given height as replayed, storing the checkpoint if enough heights have been replayed
+func (t *checkpointTracker) markDone(height int64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.done[height] = true
+	for t.done[t.lastHeight+1] {
+		delete(t.done, t.lastHeight+1)
+		t.lastHeight++
+	}
+
+	if t.lastHeight-t.savedHeight < checkpointInterval {
+		return nil
+	}
+
+	return t.save()
+}
+
+// flush stores the checkpoint if it has changed since it was last stored
+func (t *checkpointTracker) flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.lastHeight == t.savedHeight {
+		return nil
+	}
+
+	return t.save()
+}
+
+// getLastHeight returns the latest height up to which all the heights have been replayed
+func (t *checkpointTracker) getLastHeight() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lastHeight
+}
+
+// save stores the checkpoint. It must be called while holding the lock
+func (t *checkpointTracker) save() error {
+	err := t.db.SaveBackfillCheckpoint(t.modules, t.startHeight, t.lastHeight)
+	if err != nil {
+		return err
+	}
+
+	t.savedHeight = t.lastHeight
+	return nil
+}
@@ -0,0 +1,216 @@
+package modules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	jmodules "github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+const (
+	flagFrom    = "from"
+	flagTo      = "to"
+	flagModules = "modules"
+	flagWorkers = "workers"
+	flagSource  = "source"
+	flagReset   = "reset"
+)
+
+// NewModulesCmd returns a Cobra command that allows to replay the past blocks and transactions
+// using only some of the enabled modules
+func NewModulesCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modules",
+		Short: "Replay the blocks and transactions of a heights range using the given modules",
+		Long: fmt.Sprintf(`Call the block, transaction and message handlers of the modules specified with the %[1]s flag 
+for all the heights in the range specified with the %[2]s and %[3]s flags. 
+This allows to populate a newly enabled module for the past heights without having to re-sync the whole database. 
+
+By default, the transactions are read from the database, and only the blocks, block results and validators are fetched 
+from the node. Since the database does not contain the transaction events, use "--%[4]s %[5]s" to fetch the transactions 
+from the node if the modules rely on them. 
+
+The progress is stored every %[6]d heights, and running the same command again resumes from the latest stored height. 
+Use the %[7]s flag to start again from the beginning.
+`, flagModules, flagFrom, flagTo, flagSource, sourceNode, checkpointInterval, flagReset),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			names, _ := cmd.Flags().GetStringSlice(flagModules)
+			modules, err := getModules(parseCtx.Modules, names)
+			if err != nil {
+				return err
+			}
+
+			source, _ := cmd.Flags().GetString(flagSource)
+			if source != sourceDatabase && source != sourceNode {
+				return fmt.Errorf("invalid %s: %s, must be either %s or %s", flagSource, source, sourceDatabase, sourceNode)
+			}
+
+			workers, _ := cmd.Flags().GetInt(flagWorkers)
+			if workers <= 0 {
+				return fmt.Errorf("invalid %s: %d, must be greater than 0", flagWorkers, workers)
+			}
+
+			from, _ := cmd.Flags().GetInt64(flagFrom)
+			to, _ := cmd.Flags().GetInt64(flagTo)
+
+			if from == 0 {
+				from = config.Cfg.Parser.StartHeight
+			}
+
+			if to == 0 {
+				to, err = db.GetLastBlockHeight()
+				if err != nil {
+					return fmt.Errorf("error while getting last block height: %s", err)
+				}
+			}
+
+			// Resume from the latest checkpoint, if any
+			key := getModulesKey(modules)
+			reset, _ := cmd.Flags().GetBool(flagReset)
+			if reset {
+				err = db.DeleteBackfillCheckpoint(key, from)
+				if err != nil {
+					return err
+				}
+			}
+
+			lastHeight, err := db.GetBackfillCheckpoint(key, from)
+			if err != nil {
+				return err
+			}
+
+			start := from
+			if lastHeight >= from {
+				start = lastHeight + 1
+			}
+
+			if start > to {
+				log.Info().Str("modules", key).Int64("last height", lastHeight).Msg("modules already replayed")
+				return nil
+			}
+
+			log.Info().Str("modules", key).Int64("start height", start).Int64("end height", to).
+				Str("source", source).Int("workers", workers).Msg("replaying modules")
+
+			replayer := newReplayer(parseCtx, db, modules, source)
+			tracker := newCheckpointTracker(db, key, from, start-1)
+
+			var failed int64
+			heights := make(chan int64)
+			var wg sync.WaitGroup
+			for i := 0; i < workers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for height := range heights {
+						err := replayer.replay(height)
+						if err != nil {
+							atomic.AddInt64(&failed, 1)
+							log.Error().Int64("height", height).Err(err).Msg("error while replaying height")
+							continue
+						}
+
+						err = tracker.markDone(height)
+						if err != nil {
+							log.Error().Int64("height", height).Err(err).Msg("error while storing backfill checkpoint")
+						}
+					}
+				}()
+			}
+
+			for height := start; height <= to; height++ {
+				heights <- height
+			}
+			close(heights)
+			wg.Wait()
+
+			err = tracker.flush()
+			if err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to replay %d heights, run the same command again to resume from height %d",
+					failed, tracker.getLastHeight()+1)
+			}
+
+			log.Info().Str("modules", key).Int64("end height", to).Msg("modules replayed")
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "Height from which to start replaying. If 0, the start height inside the config will be used instead")
+	cmd.Flags().Int64(flagTo, 0, "Height at which to stop replaying. If 0, the latest height stored inside the database will be used instead")
+	cmd.Flags().StringSlice(flagModules, nil, "Comma separated names of the modules to be replayed. They must be enabled inside the config")
+	cmd.Flags().Int(flagWorkers, 1, "Number of heights to be replayed concurrently")
+	cmd.Flags().String(flagSource, sourceDatabase, fmt.Sprintf("Source of the transactions, either %s or %s", sourceDatabase, sourceNode))
+	cmd.Flags().Bool(flagReset, false, "Ignore the stored progress and start replaying from the beginning")
+
+	_ = cmd.MarkFlagRequired(flagModules)
+
+	return cmd
+}
+
+// getModules returns the modules having the given names among the given enabled modules,
+// keeping the order in which they have been enabled so that they are called as during the normal parsing
+func getModules(enabled []jmodules.Module, names []string) ([]jmodules.Module, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no modules specified")
+	}
+
+	selected := map[string]bool{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if !isEnabled(enabled, name) {
+			return nil, fmt.Errorf("module %s is not enabled inside the config", name)
+		}
+		selected[name] = true
+	}
+
+	var modules []jmodules.Module
+	for _, module := range enabled {
+		if selected[module.Name()] {
+			modules = append(modules, module)
+		}
+	}
+
+	return modules, nil
+}
+
+// isEnabled tells whether the module having the given name is among the given enabled modules
+func isEnabled(enabled []jmodules.Module, name string) bool {
+	for _, module := range enabled {
+		if module.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// getModulesKey returns the key identifying the backfill of the given modules,
+// which does not depend on the order in which the modules are given
+func getModulesKey(modules []jmodules.Module) string {
+	names := make([]string, len(modules))
+	for index, module := range modules {
+		names[index] = module.Name()
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
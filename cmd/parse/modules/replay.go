@@ -0,0 +1,211 @@
+package modules
+
+import (
+	"fmt"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	jmodules "github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/parser"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+const (
+	sourceDatabase = "database"
+	sourceNode     = "node"
+)
+
+// replayer replays the blocks and transactions of single heights using a given set of modules
+type replayer struct {
+	ctx     *parser.Context
+	db      *database.Db
+	modules []jmodules.Module
+	source  string
+}
+
+// newReplayer returns a new replayer instance
+func newReplayer(ctx *parser.Context, db *database.Db, modules []jmodules.Module, source string) *replayer {
+	return &replayer{
+		ctx:     ctx,
+		db:      db,
+		modules: modules,
+		source:  source,
+	}
+}
+
+// hasBlockModules tells whether any of the modules handles blocks
+func (r *replayer) hasBlockModules() bool {
+	for _, module := range r.modules {
+		if _, ok := module.(jmodules.BlockModule); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// replay calls the handlers of all the modules for the block having the given height,
+// as well as for all its transactions and messages
+func (r *replayer) replay(height int64) error {
+	block, err := r.ctx.Node.Block(height)
+	if err != nil {
+		return fmt.Errorf("failed to get block from node: %s", err)
+	}
+
+	txs, err := r.getTxs(block)
+	if err != nil {
+		return err
+	}
+
+	// Block results and validators are only needed by the block handlers
+	if r.hasBlockModules() {
+		err = r.replayBlock(block, txs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, tx := range txs {
+		err = r.replayTx(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayBlock calls the block handlers of all the modules for the given block, committing their batched
+// writes atomically. As when parsing new blocks, the batch is committed before the transactions are handled,
+// so that their handlers can read the data written by the block handlers
+func (r *replayer) replayBlock(block *tmctypes.ResultBlock, txs []*juno.Tx) error {
+	height := block.Block.Height
+
+	results, err := r.ctx.Node.BlockResults(height)
+	if err != nil {
+		return fmt.Errorf("failed to get block results from node: %s", err)
+	}
+
+	vals, err := r.ctx.Node.Validators(height)
+	if err != nil {
+		return fmt.Errorf("failed to get validators for block: %s", err)
+	}
+
+	r.db.BeginBlockBatch(height)
+
+	for _, module := range r.modules {
+		if blockModule, ok := module.(jmodules.BlockModule); ok {
+			err = blockModule.HandleBlock(block, results, txs, vals)
+			if err != nil {
+				r.db.DiscardBlockBatch(height)
+				return fmt.Errorf("error while handling block with %s module: %s", module.Name(), err)
+			}
+		}
+	}
+
+	return r.db.CommitBlockBatch(height)
+}
+
+// getTxs returns the transactions contained inside the given block, in the same order as they appear
+// inside the block. When the source is the database, the transactions that are not stored
+// are fetched from the node instead
+func (r *replayer) getTxs(block *tmctypes.ResultBlock) ([]*juno.Tx, error) {
+	if r.source == sourceNode {
+		txs, err := r.ctx.Node.Txs(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transactions for block: %s", err)
+		}
+		return txs, nil
+	}
+
+	stored, err := r.db.GetTransactions(block.Block.Height, block.Block.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*juno.Tx, len(block.Block.Txs))
+	for index, tmTx := range block.Block.Txs {
+		hash := fmt.Sprintf("%X", tmTx.Hash())
+
+		tx, found := stored[hash]
+		if !found {
+			tx, err = r.ctx.Node.Tx(hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get transaction %s from node: %s", hash, err)
+			}
+		}
+
+		txs[index] = tx
+	}
+
+	return txs, nil
+}
+
+// replayTx calls the transaction handlers of all the modules for the given transaction,
+// as well as the message handlers for all its messages
+func (r *replayer) replayTx(tx *juno.Tx) error {
+	for _, module := range r.modules {
+		if transactionModule, ok := module.(jmodules.TransactionModule); ok {
+			err := transactionModule.HandleTx(tx)
+			if err != nil {
+				return fmt.Errorf("error while handling tx %s with %s module: %s", tx.TxHash, module.Name(), err)
+			}
+		}
+	}
+
+	for index, msgAny := range tx.Body.Messages {
+		var msg sdk.Msg
+		err := r.ctx.EncodingConfig.Codec.UnpackAny(msgAny, &msg)
+		if err != nil {
+			return fmt.Errorf("error while unpacking message of tx %s: %s", tx.TxHash, err)
+		}
+
+		err = r.replayMsg(index, msg, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayMsg calls the message handlers of all the modules for the message having the given index
+// inside the given transaction, including the messages executed using an authz.MsgExec
+func (r *replayer) replayMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+	for _, module := range r.modules {
+		if messageModule, ok := module.(jmodules.MessageModule); ok {
+			err := messageModule.HandleMsg(index, msg, tx)
+			if err != nil {
+				return fmt.Errorf("error while handling message %d of tx %s with %s module: %s",
+					index, tx.TxHash, module.Name(), err)
+			}
+		}
+	}
+
+	msgExec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil
+	}
+
+	for authzIndex, msgAny := range msgExec.Msgs {
+		var executedMsg sdk.Msg
+		err := r.ctx.EncodingConfig.Codec.UnpackAny(msgAny, &executedMsg)
+		if err != nil {
+			return fmt.Errorf("error while unpacking MsgExec inner message of tx %s: %s", tx.TxHash, err)
+		}
+
+		for _, module := range r.modules {
+			if messageModule, ok := module.(jmodules.AuthzMessageModule); ok {
+				err = messageModule.HandleMsgExec(index, msgExec, authzIndex, executedMsg, tx)
+				if err != nil {
+					return fmt.Errorf("error while handling MsgExec inner message %d of tx %s with %s module: %s",
+						authzIndex, tx.TxHash, module.Name(), err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -12,12 +12,17 @@ import (
 
 	parseauth "github.com/forbole/callisto/v4/cmd/parse/auth"
 	parsebank "github.com/forbole/callisto/v4/cmd/parse/bank"
+	parseconsensus "github.com/forbole/callisto/v4/cmd/parse/consensus"
 	parsedistribution "github.com/forbole/callisto/v4/cmd/parse/distribution"
 	parsefeegrant "github.com/forbole/callisto/v4/cmd/parse/feegrant"
 	parsegov "github.com/forbole/callisto/v4/cmd/parse/gov"
+	parsemessagetype "github.com/forbole/callisto/v4/cmd/parse/message_type"
 	parsemint "github.com/forbole/callisto/v4/cmd/parse/mint"
+	parsemodules "github.com/forbole/callisto/v4/cmd/parse/modules"
 	parsepricefeed "github.com/forbole/callisto/v4/cmd/parse/pricefeed"
+	parseslashing "github.com/forbole/callisto/v4/cmd/parse/slashing"
 	parsestaking "github.com/forbole/callisto/v4/cmd/parse/staking"
+	parseupgrade "github.com/forbole/callisto/v4/cmd/parse/upgrade"
 )
 
 // NewParseCmd returns the Cobra command allowing to parse some chain data without having to re-sync the whole database
@@ -25,27 +30,33 @@ func NewParseCmd(parseCfg *parse.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "parse",
 		Short:             "Parse some data without the need to re-syncing the whole database from scratch",
-		PersistentPreRunE: runPersistentPreRuns(parse.ReadConfigPreRunE(parseCfg)),
+		PersistentPreRunE: RunPersistentPreRuns(parse.ReadConfigPreRunE(parseCfg)),
 	}
 
 	cmd.AddCommand(
 		parseauth.NewAuthCmd(parseCfg),
 		parsebank.NewBankCmd(parseCfg),
 		parseblocks.NewBlocksCmd(parseCfg),
+		parseconsensus.NewConsensusCmd(parseCfg),
 		parsedistribution.NewDistributionCmd(parseCfg),
 		parsefeegrant.NewFeegrantCmd(parseCfg),
 		parsegenesis.NewGenesisCmd(parseCfg),
 		parsegov.NewGovCmd(parseCfg),
+		parsemessagetype.NewMessageTypeCmd(parseCfg),
 		parsemint.NewMintCmd(parseCfg),
+		parsemodules.NewModulesCmd(parseCfg),
 		parsepricefeed.NewPricefeedCmd(parseCfg),
+		parseslashing.NewSlashingCmd(parseCfg),
 		parsestaking.NewStakingCmd(parseCfg),
 		parsetransaction.NewTransactionsCmd(parseCfg),
+		parseupgrade.NewUpgradeCmd(parseCfg),
 	)
 
 	return cmd
 }
 
-func runPersistentPreRuns(preRun func(_ *cobra.Command, _ []string) error) func(_ *cobra.Command, _ []string) error {
+// RunPersistentPreRuns returns a pre-run function running the root persistent pre-run before the given one
+func RunPersistentPreRuns(preRun func(_ *cobra.Command, _ []string) error) func(_ *cobra.Command, _ []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		if root := cmd.Root(); root != nil {
 			if root.PersistentPreRunE != nil {
@@ -0,0 +1,78 @@
+package slashing
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/parser"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/slashing"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+)
+
+const (
+	flagHeight = "height"
+)
+
+// paramsCmd returns the Cobra command allowing to refresh x/slashing params
+func paramsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Refresh the slashing params",
+		Long: fmt.Sprintf(`Refresh the slashing params stored inside the database. 
+By default, the params are read at the latest chain height. 
+You can read them at a specific height by using the %s flag.
+`, flagHeight),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build slashing module
+			slashingModule := slashing.NewModule(sources.SlashingSource, parseCtx.EncodingConfig.Codec, db)
+
+			height, err := getHeight(cmd, parseCtx)
+			if err != nil {
+				return err
+			}
+
+			err = slashingModule.UpdateParams(height)
+			if err != nil {
+				return fmt.Errorf("error while updating slashing params: %s", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height at which to read the params. If 0, the latest chain height will be used instead")
+
+	return cmd
+}
+
+// getHeight returns the height specified using the height flag, or the latest chain height if no height is given
+func getHeight(cmd *cobra.Command, parseCtx *parser.Context) (int64, error) {
+	height, _ := cmd.Flags().GetInt64(flagHeight)
+	if height != 0 {
+		return height, nil
+	}
+
+	height, err := parseCtx.Node.LatestHeight()
+	if err != nil {
+		return 0, fmt.Errorf("error while getting chain latest block height: %s", err)
+	}
+
+	return height, nil
+}
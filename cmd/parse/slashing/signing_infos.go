@@ -0,0 +1,58 @@
+package slashing
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/slashing"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+)
+
+// signingInfosCmd returns the Cobra command allowing to refresh the validators signing infos
+func signingInfosCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "Refresh the signing infos of all the validators",
+		Long: fmt.Sprintf(`Refresh the signing infos of all the validators stored inside the database. 
+By default, the signing infos are read at the latest chain height. 
+You can read them at a specific height by using the %s flag.
+`, flagHeight),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build slashing module
+			slashingModule := slashing.NewModule(sources.SlashingSource, parseCtx.EncodingConfig.Codec, db)
+
+			height, err := getHeight(cmd, parseCtx)
+			if err != nil {
+				return err
+			}
+
+			err = slashingModule.UpdateSigningInfo(height)
+			if err != nil {
+				return fmt.Errorf("error while updating signing infos: %s", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height at which to read the signing infos. If 0, the latest chain height will be used instead")
+
+	return cmd
+}
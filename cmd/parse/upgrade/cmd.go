@@ -0,0 +1,20 @@
+package upgrade
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewUpgradeCmd returns the Cobra command that allows to fix all the things related to the x/upgrade module
+func NewUpgradeCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Fix things related to the x/upgrade module",
+	}
+
+	cmd.AddCommand(
+		plansCmd(parseConfig),
+	)
+
+	return cmd
+}
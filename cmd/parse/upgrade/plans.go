@@ -0,0 +1,88 @@
+package upgrade
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/bank"
+	"github.com/forbole/callisto/v4/modules/distribution"
+	"github.com/forbole/callisto/v4/modules/gov"
+	"github.com/forbole/callisto/v4/modules/mint"
+	"github.com/forbole/callisto/v4/modules/slashing"
+	"github.com/forbole/callisto/v4/modules/staking"
+	modulestypes "github.com/forbole/callisto/v4/modules/types"
+	"github.com/forbole/callisto/v4/modules/upgrade"
+)
+
+// plansCmd returns the Cobra command allowing to apply the software upgrade plans
+// whose upgrade height has already been parsed
+func plansCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plans",
+		Short: "Apply the stored software upgrade plans whose upgrade height has already been parsed",
+		Long: `Apply all the software upgrade plans stored inside the database having an upgrade height lower or equal 
+to the latest stored block height. This refreshes the validators and the params of all the modules at the upgrade height, 
+marks the upgrade as applied inside the history and deletes the plan. 
+This is useful when a plan has been stored after its upgrade block was parsed, for example when re-parsing a proposal.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the modules whose data should be refreshed upon a software upgrade
+			cdc := parseCtx.EncodingConfig.Codec
+			bankModule := bank.NewModule(config.Cfg, nil, sources.BankSource, cdc, db)
+			distrModule := distribution.NewModule(sources.DistrSource, cdc, db)
+			mintModule := mint.NewModule(sources.MintSource, cdc, db)
+			slashingModule := slashing.NewModule(sources.SlashingSource, cdc, db)
+			stakingModule := staking.NewModule(sources.StakingSource, cdc, db)
+			govModule := gov.NewModule(sources.GovSource, bankModule, distrModule, mintModule, slashingModule, stakingModule, cdc, db)
+
+			// Build the upgrade module
+			upgradeModule := upgrade.NewModule(db, stakingModule, []upgrade.ParamsModule{
+				bankModule, distrModule, govModule, mintModule, slashingModule, stakingModule,
+			})
+
+			lastHeight, err := db.GetLastBlockHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting last block height: %s", err)
+			}
+
+			heights, err := db.GetPassedSoftwareUpgradePlanHeights(lastHeight)
+			if err != nil {
+				return err
+			}
+
+			for _, height := range heights {
+				log.Info().Int64("upgrade height", height).Msg("applying software upgrade plan")
+
+				block, err := parseCtx.Node.Block(height)
+				if err != nil {
+					return fmt.Errorf("error while getting block at height %d: %s", height, err)
+				}
+
+				err = upgradeModule.RefreshDataUponSoftwareUpgrade(height, block.Block.Time)
+				if err != nil {
+					return fmt.Errorf("error while applying software upgrade plan at height %d: %s", height, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package partitions
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/partitions"
+)
+
+const (
+	flagKeep = "keep"
+)
+
+// archiveCmd returns the Cobra command allowing to detach and archive the old partitions
+func archiveCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Detach and archive the partitions older than the given number of latest partitions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keep, _ := cmd.Flags().GetInt64(flagKeep)
+			if keep <= 0 {
+				return fmt.Errorf("the number of partitions to keep must be greater than 0")
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build partitions module
+			partitionsModule := partitions.NewModule(config.Cfg, db)
+
+			return partitionsModule.ArchivePartitions(keep)
+		},
+	}
+
+	cmd.Flags().Int64(flagKeep, 0, "Number of latest partitions to keep attached, including the one containing the latest stored block")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package partitions
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+
+	parsecmd "github.com/forbole/callisto/v4/cmd/parse"
+)
+
+// NewPartitionsCmd returns the Cobra command allowing to manage the partitions of the transaction and message tables
+func NewPartitionsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "partitions",
+		Short:             "Manage the partitions of the transaction and message tables",
+		PersistentPreRunE: parsecmd.RunPersistentPreRuns(parsecmdtypes.ReadConfigPreRunE(parseConfig)),
+	}
+
+	cmd.AddCommand(
+		archiveCmd(parseConfig),
+		ensureCmd(parseConfig),
+		statusCmd(parseConfig),
+	)
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package partitions
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+	"github.com/forbole/callisto/v4/modules/partitions"
+)
+
+// ensureCmd returns the Cobra command allowing to create the partitions ahead of the latest stored block
+func ensureCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ensure",
+		Short: "Create the partitions containing the latest stored block and the configured ones after it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build partitions module
+			partitionsModule := partitions.NewModule(config.Cfg, db)
+
+			return partitionsModule.EnsurePartitions()
+		},
+	}
+}
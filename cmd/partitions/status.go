@@ -0,0 +1,79 @@
+package partitions
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// statusCmd returns the Cobra command allowing to list the partitions along with their sizes and height ranges
+func statusCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "List the partitions of the transaction and message tables along with their sizes and height ranges",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(writer, "TABLE\tPARTITION\tMIN HEIGHT\tMAX HEIGHT\tSIZE\tROWS (EST.)")
+
+			for _, table := range database.PartitionedTables {
+				partitions, err := db.GetPartitions(table)
+				if err != nil {
+					return err
+				}
+
+				for _, partition := range partitions {
+					heightRange, err := db.GetPartitionHeightRange(partition.Name)
+					if err != nil {
+						return err
+					}
+
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%d\n",
+						table, partition.Name,
+						formatHeight(heightRange.MinHeight.Int64, heightRange.MinHeight.Valid),
+						formatHeight(heightRange.MaxHeight.Int64, heightRange.MaxHeight.Valid),
+						formatSize(partition.Size), partition.RowsEstimate,
+					)
+				}
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+// formatHeight returns the given height as a string, or a dash if it is not valid
+func formatHeight(height int64, valid bool) string {
+	if !valid {
+		return "-"
+	}
+	return fmt.Sprintf("%d", height)
+}
+
+// formatSize returns the given size in bytes as a human readable string
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
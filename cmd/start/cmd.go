@@ -0,0 +1,29 @@
+package start
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	startcmd "github.com/forbole/juno/v5/cmd/start"
+	"github.com/spf13/cobra"
+
+	migratecmd "github.com/forbole/callisto/v4/cmd/migrate"
+)
+
+// NewStartCmd returns the command that should be run to start parsing the chain state.
+// Before starting, all the pending schema migrations are applied to the database
+func NewStartCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := startcmd.NewStartCmd(parseConfig)
+
+	preRun := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRun != nil {
+			err := preRun(cmd, args)
+			if err != nil {
+				return err
+			}
+		}
+
+		return migratecmd.RunMigrationsUp(parseConfig)
+	}
+
+	return cmd
+}
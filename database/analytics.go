@@ -0,0 +1,333 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// CountNewAccounts returns the number of the given addresses that are not yet stored inside the account table
+func (db *Db) CountNewAccounts(addresses []string) (int64, error) {
+	if len(addresses) == 0 {
+		return 0, nil
+	}
+
+	stmt := `
+SELECT COUNT(DISTINCT involved.address) 
+FROM unnest($1::TEXT[]) AS involved(address)
+WHERE NOT EXISTS (SELECT 1 FROM account WHERE account.address = involved.address)`
+
+	var count int64
+	err := db.SQL.QueryRow(stmt, pq.Array(addresses)).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error while counting new accounts: %s", err)
+	}
+
+	return count, nil
+}
+
+// SaveBlockActivity adds the given block activity to the chain activity buckets of all the periods.
+// Blocks that have already been included are ignored, so that the same height can be handled more than once
+func (db *Db) SaveBlockActivity(activity types.BlockActivity) error {
+	return db.execBlockOperation(activity.Height, func(tx *sql.Tx) error {
+		res, err := tx.Exec(`INSERT INTO chain_activity_block (height) VALUES ($1) ON CONFLICT DO NOTHING`, activity.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing chain activity block: %s", err)
+		}
+
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error while getting chain activity block rows: %s", err)
+		}
+
+		// Skip if the block has already been included
+		if inserted == 0 {
+			return nil
+		}
+
+		for _, period := range types.ActivityPeriods {
+			err = saveBlockActivity(tx, period, activity)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// saveBlockActivity adds the given block activity to the chain activity bucket of the given period
+func saveBlockActivity(tx *sql.Tx, period string, activity types.BlockActivity) error {
+	stmt := `
+INSERT INTO chain_activity (
+    period, bucket, txs_count, successful_txs, failed_txs, new_accounts, gas_used, gas_wanted, height
+) 
+VALUES ($1, date_trunc($1, $2::TIMESTAMP), $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (period, bucket) DO UPDATE 
+    SET txs_count = chain_activity.txs_count + excluded.txs_count,
+        successful_txs = chain_activity.successful_txs + excluded.successful_txs,
+        failed_txs = chain_activity.failed_txs + excluded.failed_txs,
+        new_accounts = chain_activity.new_accounts + excluded.new_accounts,
+        gas_used = chain_activity.gas_used + excluded.gas_used,
+        gas_wanted = chain_activity.gas_wanted + excluded.gas_wanted,
+        height = GREATEST(chain_activity.height, excluded.height)`
+
+	_, err := tx.Exec(stmt,
+		period, activity.Timestamp, activity.TxsCount, activity.SuccessfulTxs, activity.FailedTxs,
+		activity.NewAccounts, activity.GasUsed, activity.GasWanted, activity.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing %s chain activity: %s", period, err)
+	}
+
+	if len(activity.Signers) > 0 {
+		stmt = `
+INSERT INTO chain_activity_signer (period, bucket, address) 
+SELECT $1::TEXT, date_trunc($1, $2::TIMESTAMP), unnest($3::TEXT[])
+ON CONFLICT DO NOTHING`
+
+		res, err := tx.Exec(stmt, period, activity.Timestamp, pq.Array(activity.Signers))
+		if err != nil {
+			return fmt.Errorf("error while storing %s chain activity signers: %s", period, err)
+		}
+
+		newSigners, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error while getting %s chain activity signers rows: %s", period, err)
+		}
+
+		_, err = tx.Exec(`
+UPDATE chain_activity SET active_signers = active_signers + $3 
+WHERE period = $1 AND bucket = date_trunc($1, $2::TIMESTAMP)`, period, activity.Timestamp, newSigners)
+		if err != nil {
+			return fmt.Errorf("error while updating %s chain activity signers: %s", period, err)
+		}
+	}
+
+	for _, fee := range activity.Fees {
+		stmt = `
+INSERT INTO chain_activity_fee (period, bucket, denom, amount) 
+VALUES ($1, date_trunc($1, $2::TIMESTAMP), $3, $4)
+ON CONFLICT (period, bucket, denom) DO UPDATE 
+    SET amount = chain_activity_fee.amount + excluded.amount`
+
+		_, err = tx.Exec(stmt, period, activity.Timestamp, fee.Denom, fee.Amount.String())
+		if err != nil {
+			return fmt.Errorf("error while storing %s chain activity fees: %s", period, err)
+		}
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveBlockFeeMarket stores the given fee market statistics and gas prices of a single block
+func (db *Db) SaveBlockFeeMarket(feeMarket types.FeeMarket, gasPrices []types.GasPrice) error {
+	feeMarketStmt := `
+INSERT INTO fee_market_block (height, txs_count, gas_used, gas_wanted, gas_efficiency, out_of_gas_txs, timestamp) 
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (height) DO UPDATE 
+    SET txs_count = excluded.txs_count,
+        gas_used = excluded.gas_used,
+        gas_wanted = excluded.gas_wanted,
+        gas_efficiency = excluded.gas_efficiency,
+        out_of_gas_txs = excluded.out_of_gas_txs,
+        timestamp = excluded.timestamp`
+
+	gasPricesStmt := `
+INSERT INTO gas_price_block (height, denom, txs_count, min_gas_price, median_gas_price, p90_gas_price) 
+VALUES `
+
+	var params []interface{}
+	for i, gasPrice := range gasPrices {
+		gi := i * 6
+		gasPricesStmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),", gi+1, gi+2, gi+3, gi+4, gi+5, gi+6)
+		params = append(params,
+			feeMarket.Height,
+			gasPrice.Denom,
+			gasPrice.TxsCount,
+			gasPrice.MinGasPrice.String(),
+			gasPrice.MedianGasPrice.String(),
+			gasPrice.P90GasPrice.String(),
+		)
+	}
+
+	gasPricesStmt = gasPricesStmt[:len(gasPricesStmt)-1] // Remove trailing ","
+	gasPricesStmt += `
+ON CONFLICT (height, denom) DO UPDATE 
+    SET txs_count = excluded.txs_count,
+        min_gas_price = excluded.min_gas_price,
+        median_gas_price = excluded.median_gas_price,
+        p90_gas_price = excluded.p90_gas_price`
+
+	return db.execBlockOperation(feeMarket.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(feeMarketStmt,
+			feeMarket.Height, feeMarket.TxsCount, feeMarket.GasUsed, feeMarket.GasWanted,
+			feeMarket.GasEfficiency.String(), feeMarket.OutOfGasTxs, feeMarket.Timestamp,
+		)
+		if err != nil {
+			return fmt.Errorf("error while storing block fee market: %s", err)
+		}
+
+		if len(gasPrices) == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(gasPricesStmt, params...)
+		if err != nil {
+			return fmt.Errorf("error while storing block gas prices: %s", err)
+		}
+
+		return nil
+	})
+}
+
+// GetLastFeeMarketHourlyBucket returns the start time of the latest hourly fee market bucket
+// stored inside the database, or nil if no bucket is found
+func (db *Db) GetLastFeeMarketHourlyBucket() (*time.Time, error) {
+	var buckets []time.Time
+	err := db.Sqlx.Select(&buckets, `SELECT bucket FROM fee_market_hourly ORDER BY bucket DESC LIMIT 1`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting last hourly fee market bucket: %s", err)
+	}
+
+	if len(buckets) == 0 {
+		return nil, nil
+	}
+
+	return &buckets[0], nil
+}
+
+// SaveHourlyFeeMarket computes the hourly fee market statistics and gas prices of the blocks
+// having a timestamp within the given time range, and stores them.
+// The statistics are computed using a read replica when one has already stored the given height
+func (db *Db) SaveHourlyFeeMarket(from, to time.Time, height int64) error {
+	reader := db.Reader(height)
+
+	stmt := `
+SELECT date_trunc('hour', timestamp) AS bucket,
+       SUM(txs_count) AS txs_count,
+       SUM(gas_used) AS gas_used,
+       SUM(gas_wanted) AS gas_wanted,
+       COALESCE(ROUND(SUM(gas_used)::NUMERIC / NULLIF(SUM(gas_wanted), 0), 18), 0) AS gas_efficiency,
+       SUM(out_of_gas_txs) AS out_of_gas_txs
+FROM fee_market_block
+WHERE timestamp >= $1 AND timestamp < $2
+GROUP BY bucket`
+
+	var feeMarketRows []dbtypes.FeeMarketHourlyRow
+	err := reader.Select(&feeMarketRows, stmt, from, to)
+	if err != nil {
+		return fmt.Errorf("error while computing hourly fee market: %s", err)
+	}
+
+	stmt = `
+SELECT date_trunc('hour', gas_price.timestamp) AS bucket,
+       gas_price.denom,
+       COUNT(*) AS txs_count,
+       ROUND(MIN(gas_price.price), 18) AS min_gas_price,
+       ROUND((percentile_cont(0.5) WITHIN GROUP (ORDER BY gas_price.price))::NUMERIC, 18) AS median_gas_price,
+       ROUND((percentile_cont(0.9) WITHIN GROUP (ORDER BY gas_price.price))::NUMERIC, 18) AS p90_gas_price
+FROM (
+    SELECT block.timestamp, fee.denom, fee.amount::NUMERIC / transaction.gas_wanted AS price
+    FROM transaction
+    JOIN block ON block.height = transaction.height
+    CROSS JOIN LATERAL jsonb_to_recordset(transaction.fee -> 'amount') AS fee(denom TEXT, amount TEXT)
+    WHERE transaction.gas_wanted > 0 AND block.timestamp >= $1 AND block.timestamp < $2
+) AS gas_price
+GROUP BY bucket, gas_price.denom`
+
+	var gasPriceRows []dbtypes.GasPriceHourlyRow
+	err = reader.Select(&gasPriceRows, stmt, from, to)
+	if err != nil {
+		return fmt.Errorf("error while computing hourly gas prices: %s", err)
+	}
+
+	feeMarketInsert := newBulkInsert(
+		"fee_market_hourly",
+		[]string{"bucket", "txs_count", "gas_used", "gas_wanted", "gas_efficiency", "out_of_gas_txs"},
+		`ON CONFLICT (bucket) DO UPDATE 
+    SET txs_count = excluded.txs_count,
+        gas_used = excluded.gas_used,
+        gas_wanted = excluded.gas_wanted,
+        gas_efficiency = excluded.gas_efficiency,
+        out_of_gas_txs = excluded.out_of_gas_txs`,
+	)
+	for _, row := range feeMarketRows {
+		feeMarketInsert.add(row.Bucket, row.TxsCount, row.GasUsed, row.GasWanted, row.GasEfficiency, row.OutOfGasTxs)
+	}
+
+	gasPriceInsert := newBulkInsert(
+		"gas_price_hourly",
+		[]string{"bucket", "denom", "txs_count", "min_gas_price", "median_gas_price", "p90_gas_price"},
+		`ON CONFLICT (bucket, denom) DO UPDATE 
+    SET txs_count = excluded.txs_count,
+        min_gas_price = excluded.min_gas_price,
+        median_gas_price = excluded.median_gas_price,
+        p90_gas_price = excluded.p90_gas_price`,
+	)
+	for _, row := range gasPriceRows {
+		gasPriceInsert.add(row.Bucket, row.Denom, row.TxsCount, row.MinGasPrice, row.MedianGasPrice, row.P90GasPrice)
+	}
+
+	return db.execOperations([]blockOperation{
+		func(tx *sql.Tx) error {
+			err := feeMarketInsert.exec(tx)
+			if err != nil {
+				return fmt.Errorf("error while storing hourly fee market: %s", err)
+			}
+			return nil
+		},
+		func(tx *sql.Tx) error {
+			err := gasPriceInsert.exec(tx)
+			if err != nil {
+				return fmt.Errorf("error while storing hourly gas prices: %s", err)
+			}
+			return nil
+		},
+	})
+}
+
+// GetLatestHourlyGasPrices returns, for each fee denom, the gas prices of the latest hour
+// in which a transaction paying fees with that denom has been included
+func (db *Db) GetLatestHourlyGasPrices() ([]types.GasPrice, error) {
+	stmt := `
+SELECT DISTINCT ON (denom) denom, txs_count, min_gas_price, median_gas_price, p90_gas_price
+FROM gas_price_hourly
+ORDER BY denom, bucket DESC`
+
+	var rows []dbtypes.GasPriceRow
+	err := db.LatestReader().Select(&rows, stmt)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting latest hourly gas prices: %s", err)
+	}
+
+	gasPrices := make([]types.GasPrice, len(rows))
+	for i, row := range rows {
+		minGasPrice, err := sdk.NewDecFromStr(row.MinGasPrice)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing min gas price: %s", err)
+		}
+
+		medianGasPrice, err := sdk.NewDecFromStr(row.MedianGasPrice)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing median gas price: %s", err)
+		}
+
+		p90GasPrice, err := sdk.NewDecFromStr(row.P90GasPrice)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing p90 gas price: %s", err)
+		}
+
+		gasPrices[i] = types.NewGasPrice(row.Denom, row.TxsCount, minGasPrice, medianGasPrice, p90GasPrice)
+	}
+
+	return gasPrices, nil
+}
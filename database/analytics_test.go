@@ -0,0 +1,170 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_CountNewAccounts() {
+	suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	count, err := suite.database.CountNewAccounts([]string{
+		"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveBlockActivity() {
+	first := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+	second := "cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a"
+
+	err := suite.database.SaveBlockActivity(types.NewBlockActivity(
+		2, 1, 1, []string{first}, 1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+		1000, 2000, 10, time.Date(2020, 1, 1, 15, 10, 0, 0, time.UTC),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBlockActivity(types.NewBlockActivity(
+		1, 1, 0, []string{first, second}, 1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)),
+		500, 1000, 11, time.Date(2020, 1, 1, 16, 10, 0, 0, time.UTC),
+	))
+	suite.Require().NoError(err)
+
+	// Handling the same height twice should not change the data
+	err = suite.database.SaveBlockActivity(types.NewBlockActivity(
+		1, 1, 0, []string{first, second}, 1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)),
+		500, 1000, 11, time.Date(2020, 1, 1, 16, 10, 0, 0, time.UTC),
+	))
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		Period        string `db:"period"`
+		TxsCount      int64  `db:"txs_count"`
+		FailedTxs     int64  `db:"failed_txs"`
+		ActiveSigners int64  `db:"active_signers"`
+		NewAccounts   int64  `db:"new_accounts"`
+		GasUsed       int64  `db:"gas_used"`
+		Height        int64  `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT period, txs_count, failed_txs, active_signers, new_accounts, gas_used, height 
+FROM chain_activity ORDER BY period, bucket`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+
+	// Daily bucket
+	suite.Require().Equal(types.ActivityPeriodDay, rows[0].Period)
+	suite.Require().Equal(int64(3), rows[0].TxsCount)
+	suite.Require().Equal(int64(1), rows[0].FailedTxs)
+	suite.Require().Equal(int64(2), rows[0].ActiveSigners)
+	suite.Require().Equal(int64(2), rows[0].NewAccounts)
+	suite.Require().Equal(int64(1500), rows[0].GasUsed)
+	suite.Require().Equal(int64(11), rows[0].Height)
+
+	// Hourly buckets
+	suite.Require().Equal(types.ActivityPeriodHour, rows[1].Period)
+	suite.Require().Equal(int64(2), rows[1].TxsCount)
+	suite.Require().Equal(int64(1), rows[1].ActiveSigners)
+	suite.Require().Equal(int64(1), rows[2].TxsCount)
+	suite.Require().Equal(int64(2), rows[2].ActiveSigners)
+
+	var fees []string
+	err = suite.database.Sqlx.Select(&fees,
+		`SELECT amount::TEXT FROM chain_activity_fee WHERE period = 'day' AND denom = 'uatom'`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"150"}, fees)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveHourlyFeeMarket() {
+	suite.getBlock(10)
+	suite.getBlock(11)
+	suite.getBlock(12)
+
+	timestamps := map[int64]time.Time{
+		10: time.Date(2020, 1, 1, 15, 10, 0, 0, time.UTC),
+		11: time.Date(2020, 1, 1, 15, 40, 0, 0, time.UTC),
+		12: time.Date(2020, 1, 1, 16, 10, 0, 0, time.UTC),
+	}
+	for height, timestamp := range timestamps {
+		_, err := suite.database.SQL.Exec(`UPDATE block SET timestamp = $1 WHERE height = $2`, timestamp, height)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.database.SaveBlockFeeMarket(
+		types.NewFeeMarket(2, 1000, 2000, sdk.NewDecWithPrec(5, 1), 1, 10, timestamps[10]),
+		[]types.GasPrice{
+			types.NewGasPrice("uatom", 2, sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(3, 2)),
+		},
+	)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBlockFeeMarket(
+		types.NewFeeMarket(1, 1500, 2000, sdk.NewDecWithPrec(75, 2), 0, 11, timestamps[11]),
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBlockFeeMarket(
+		types.NewFeeMarket(1, 100, 400, sdk.NewDecWithPrec(25, 2), 0, 12, timestamps[12]),
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveHourlyFeeMarket(
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		12,
+	)
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		TxsCount      int64  `db:"txs_count"`
+		GasUsed       int64  `db:"gas_used"`
+		GasWanted     int64  `db:"gas_wanted"`
+		GasEfficiency string `db:"gas_efficiency"`
+		OutOfGasTxs   int64  `db:"out_of_gas_txs"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT txs_count, gas_used, gas_wanted, gas_efficiency::TEXT, out_of_gas_txs 
+FROM fee_market_hourly ORDER BY bucket`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().Equal(int64(3), rows[0].TxsCount)
+	suite.Require().Equal(int64(2500), rows[0].GasUsed)
+	suite.Require().Equal(int64(4000), rows[0].GasWanted)
+	suite.Require().Equal(int64(1), rows[0].OutOfGasTxs)
+
+	efficiency, err := sdk.NewDecFromStr(rows[0].GasEfficiency)
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.NewDecWithPrec(625, 3).Equal(efficiency))
+
+	suite.Require().Equal(int64(1), rows[1].TxsCount)
+
+	lastBucket, err := suite.database.GetLastFeeMarketHourlyBucket()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(lastBucket)
+	suite.Require().True(time.Date(2020, 1, 1, 16, 0, 0, 0, time.UTC).Equal(*lastBucket))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetLatestHourlyGasPrices() {
+	_, err := suite.database.SQL.Exec(`
+INSERT INTO gas_price_hourly (bucket, denom, txs_count, min_gas_price, median_gas_price, p90_gas_price) 
+VALUES ('2020-01-01 15:00:00', 'uatom', 10, 0.01, 0.02, 0.03),
+       ('2020-01-01 16:00:00', 'uatom', 5, 0.02, 0.025, 0.05),
+       ('2020-01-01 15:00:00', 'udsm', 2, 1, 1, 1)`)
+	suite.Require().NoError(err)
+
+	gasPrices, err := suite.database.GetLatestHourlyGasPrices()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GasPrice{
+		types.NewGasPrice("uatom", 5, sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(25, 3), sdk.NewDecWithPrec(5, 2)),
+		types.NewGasPrice("udsm", 2, sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(1)),
+	}, gasPrices)
+}
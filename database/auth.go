@@ -1,9 +1,12 @@
 package database
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -67,7 +70,8 @@ func (db *Db) SaveVestingAccounts(vestingAccounts []exported.VestingAccount) err
 
 	for _, account := range vestingAccounts {
 		switch vestingAccount := account.(type) {
-		case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount:
+		case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount,
+			*vestingtypes.PermanentLockedAccount:
 			_, err := db.storeVestingAccount(account)
 			if err != nil {
 				return err
@@ -93,7 +97,8 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 	INSERT INTO vesting_account (type, address, original_vesting, end_time, start_time) 
 	VALUES ($1, $2, $3, $4, $5)
 	ON CONFLICT (address) DO UPDATE 
-		SET original_vesting = excluded.original_vesting, 
+		SET type = excluded.type,
+			original_vesting = excluded.original_vesting, 
 			end_time = excluded.end_time, 
 			start_time = excluded.start_time
 			RETURNING id `
@@ -120,34 +125,6 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 	return vestingAccountRowID, nil
 }
 
-func (db *Db) StoreBaseVestingAccountFromMsg(bva *vestingtypes.BaseVestingAccount, txTimestamp time.Time) error {
-	stmt := `
-	INSERT INTO vesting_account (type, address, original_vesting, start_time, end_time) 
-	VALUES ($1, $2, $3, $4, $5)
-	ON CONFLICT (address) DO UPDATE 
-		SET type = excluded.type,
-			original_vesting = excluded.original_vesting, 
-			start_time = excluded.start_time, 
-			end_time = excluded.end_time`
-
-	// Store the vesting account
-	err := db.SaveAccounts([]types.Account{types.NewAccount(bva.GetAddress().String())})
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account address: %s", err)
-	}
-
-	_, err = db.SQL.Exec(stmt,
-		proto.MessageName(bva),
-		bva.GetAddress().String(),
-		pq.Array(dbtypes.NewDbCoins(bva.OriginalVesting)),
-		txTimestamp,
-		time.Unix(bva.EndTime, 0))
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account: %s", err)
-	}
-	return nil
-}
-
 // storeVestingPeriods handles storing the vesting periods of PeriodicVestingAccount type
 func (db *Db) storeVestingPeriods(id int, vestingPeriods []vestingtypes.Period) error {
 	// Delete already existing periods
@@ -157,6 +134,10 @@ func (db *Db) storeVestingPeriods(id int, vestingPeriods []vestingtypes.Period)
 		return fmt.Errorf("error while deleting vesting period: %s", err)
 	}
 
+	if len(vestingPeriods) == 0 {
+		return nil
+	}
+
 	// Store the new periods
 	stmt = `
 INSERT INTO vesting_period (vesting_account_id, period_order, length, amount) 
@@ -187,3 +168,156 @@ func (db *Db) GetAccounts() ([]string, error) {
 	err := db.Sqlx.Select(&rows, `SELECT address FROM account`)
 	return rows, err
 }
+
+// GetVestingAccounts returns all the vesting accounts that are currently stored inside the database.
+// Accounts created using a MsgCreateVestingAccount are returned as continuous vesting accounts
+func (db *Db) GetVestingAccounts() ([]exported.VestingAccount, error) {
+	var rows []dbtypes.VestingAccountRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM vesting_account ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting accounts: %s", err)
+	}
+
+	var periodRows []dbtypes.VestingPeriodRow
+	err = db.Sqlx.Select(&periodRows, `SELECT * FROM vesting_period ORDER BY vesting_account_id, period_order`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting periods: %s", err)
+	}
+
+	periods := make(map[int64]vestingtypes.Periods)
+	for _, row := range periodRows {
+		periods[row.VestingAccountID] = append(periods[row.VestingAccountID], convertVestingPeriodRow(row))
+	}
+
+	accounts := make([]exported.VestingAccount, len(rows))
+	for i, row := range rows {
+		account, err := convertVestingAccountRow(row, periods[row.ID])
+		if err != nil {
+			return nil, err
+		}
+		accounts[i] = account
+	}
+
+	return accounts, nil
+}
+
+// GetVestingAccount returns the vesting account having the given address, or nil if no such account is stored
+func (db *Db) GetVestingAccount(address string) (exported.VestingAccount, error) {
+	// Perform all the queries inside the same snapshot so that the account and its periods are consistent
+	tx, err := db.LatestReader().BeginTxx(context.Background(), &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while beginning vesting account transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	var rows []dbtypes.VestingAccountRow
+	err = tx.Select(&rows, `SELECT * FROM vesting_account WHERE address = $1`, address)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting account: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	var periodRows []dbtypes.VestingPeriodRow
+	stmt := `SELECT * FROM vesting_period WHERE vesting_account_id = $1 ORDER BY period_order`
+	err = tx.Select(&periodRows, stmt, rows[0].ID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting periods: %s", err)
+	}
+
+	periods := make(vestingtypes.Periods, len(periodRows))
+	for i, row := range periodRows {
+		periods[i] = convertVestingPeriodRow(row)
+	}
+
+	return convertVestingAccountRow(rows[0], periods)
+}
+
+// convertVestingPeriodRow builds the vesting period represented by the given row
+func convertVestingPeriodRow(row dbtypes.VestingPeriodRow) vestingtypes.Period {
+	return vestingtypes.Period{
+		Length: row.Length,
+		Amount: row.Amount.ToCoins(),
+	}
+}
+
+// convertVestingAccountRow builds the vesting account represented by the given row and periods
+func convertVestingAccountRow(row dbtypes.VestingAccountRow, periods vestingtypes.Periods) (exported.VestingAccount, error) {
+	var startTime int64
+	if row.StartTime.Valid {
+		startTime = row.StartTime.Time.Unix()
+	}
+
+	bva := vestingtypes.NewBaseVestingAccount(
+		&authtypes.BaseAccount{Address: row.Address},
+		row.OriginalVesting.ToCoins(),
+		row.EndTime.Unix(),
+	)
+
+	switch row.Type {
+	case proto.MessageName(&vestingtypes.ContinuousVestingAccount{}),
+		proto.MessageName(&vestingtypes.BaseVestingAccount{}):
+		return vestingtypes.NewContinuousVestingAccountRaw(bva, startTime), nil
+
+	case proto.MessageName(&vestingtypes.DelayedVestingAccount{}):
+		return vestingtypes.NewDelayedVestingAccountRaw(bva), nil
+
+	case proto.MessageName(&vestingtypes.PeriodicVestingAccount{}):
+		return vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, periods), nil
+
+	case proto.MessageName(&vestingtypes.PermanentLockedAccount{}):
+		return &vestingtypes.PermanentLockedAccount{BaseVestingAccount: bva}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid vesting account type for account %s: %s", row.Address, row.Type)
+	}
+}
+
+// SaveVestingUnlockCalendar stores the given vesting unlock calendar computed at the given height,
+// removing all the entries that have been computed at a previous height
+func (db *Db) SaveVestingUnlockCalendar(calendar []types.VestingUnlockCalendarEntry, height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM vesting_unlock_calendar WHERE height < $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while deleting old vesting unlock calendar: %s", err)
+	}
+
+	if len(calendar) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO vesting_unlock_calendar (unlock_date, amount, accounts_count, height) 
+VALUES `
+
+	var params []interface{}
+	for i, entry := range calendar {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params,
+			entry.Date,
+			pq.Array(dbtypes.NewDbCoins(entry.Amount)),
+			entry.AccountsCount,
+			entry.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (unlock_date) DO UPDATE 
+    SET amount = excluded.amount,
+        accounts_count = excluded.accounts_count,
+        height = excluded.height
+WHERE vesting_unlock_calendar.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing vesting unlock calendar: %s", err)
+	}
+
+	return nil
+}
@@ -1,8 +1,12 @@
 package database_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/forbole/callisto/v4/types"
 
@@ -71,3 +75,102 @@ func (suite *DbTestSuite) TestBigDipperDb_GetAccounts() {
 		suite.Require().Equal(acc, accounts[index])
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_GetVestingAccounts() {
+	coins := sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(1000)))
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	end := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	continuous := vestingtypes.NewContinuousVestingAccount(
+		authttypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")),
+		coins, start, end,
+	)
+	delayed := vestingtypes.NewDelayedVestingAccount(
+		authttypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32("cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn")),
+		coins, end,
+	)
+	periodic := vestingtypes.NewPeriodicVestingAccount(
+		authttypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32("cosmos1eg47ue0l85lzkfgc4leske6hcah8cz3qajpjy2")),
+		coins, start, vestingtypes.Periods{
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(400)))},
+			{Length: 200, Amount: sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(600)))},
+		},
+	)
+
+	permanent := vestingtypes.NewPermanentLockedAccount(
+		authttypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32("cosmos1495ghynrns8sxfnw8mj887pgh0c9z6c4lqkzme")),
+		coins,
+	)
+
+	err := suite.database.SaveVestingAccounts([]exported.VestingAccount{continuous, delayed, periodic, permanent})
+	suite.Require().NoError(err)
+
+	accounts, err := suite.database.GetVestingAccounts()
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 4)
+
+	storedContinuous, ok := accounts[0].(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(continuous.Address, storedContinuous.Address)
+	suite.Require().Equal(start, storedContinuous.StartTime)
+	suite.Require().Equal(end, storedContinuous.EndTime)
+	suite.Require().True(coins.IsEqual(storedContinuous.OriginalVesting))
+
+	storedDelayed, ok := accounts[1].(*vestingtypes.DelayedVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(end, storedDelayed.EndTime)
+
+	storedPeriodic, ok := accounts[2].(*vestingtypes.PeriodicVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(periodic.VestingPeriods, storedPeriodic.VestingPeriods)
+	suite.Require().Equal(periodic.EndTime, storedPeriodic.EndTime)
+
+	storedPermanent, ok := accounts[3].(*vestingtypes.PermanentLockedAccount)
+	suite.Require().True(ok)
+	suite.Require().True(coins.IsEqual(storedPermanent.OriginalVesting))
+
+	// Get a single account
+	account, err := suite.database.GetVestingAccount(periodic.Address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(periodic.VestingPeriods, account.(*vestingtypes.PeriodicVestingAccount).VestingPeriods)
+
+	account, err = suite.database.GetVestingAccount("cosmos18fzr6adp3gjw43xu62vfhg248lepfwpf0pj2dm")
+	suite.Require().NoError(err)
+	suite.Require().Nil(account)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveVestingUnlockCalendar() {
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	coins := sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(1000)))
+
+	// Save the data
+	err := suite.database.SaveVestingUnlockCalendar([]types.VestingUnlockCalendarEntry{
+		types.NewVestingUnlockCalendarEntry(day, coins, 2, 10),
+		types.NewVestingUnlockCalendarEntry(day.AddDate(0, 0, 1), coins, 1, 10),
+	}, 10)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT count(*) FROM vesting_unlock_calendar`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+
+	// Save a new calendar, which should replace the old one
+	err = suite.database.SaveVestingUnlockCalendar([]types.VestingUnlockCalendarEntry{
+		types.NewVestingUnlockCalendarEntry(day.AddDate(0, 0, 1), coins, 3, 20),
+	}, 20)
+	suite.Require().NoError(err)
+
+	var accountsCount []int
+	err = suite.database.Sqlx.Select(&accountsCount, `SELECT accounts_count FROM vesting_unlock_calendar`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int{3}, accountsCount)
+
+	// Saving an empty calendar should remove everything
+	err = suite.database.SaveVestingUnlockCalendar(nil, 30)
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT count(*) FROM vesting_unlock_calendar`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
@@ -0,0 +1,173 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	juno "github.com/forbole/juno/v5/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// GetBackfillCheckpoint returns the latest height up to which the backfill of the given modules
+// started at the given height has been completed, or 0 if no checkpoint is stored
+func (db *Db) GetBackfillCheckpoint(modules string, startHeight int64) (int64, error) {
+	var heights []int64
+	stmt := `SELECT last_height FROM backfill_checkpoint WHERE modules = $1 AND start_height = $2`
+	err := db.Sqlx.Select(&heights, stmt, modules, startHeight)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting backfill checkpoint: %s", err)
+	}
+
+	if len(heights) == 0 {
+		return 0, nil
+	}
+
+	return heights[0], nil
+}
+
+// SaveBackfillCheckpoint stores the given height as the latest one up to which the backfill
+// of the given modules started at the given height has been completed
+func (db *Db) SaveBackfillCheckpoint(modules string, startHeight int64, lastHeight int64) error {
+	stmt := `
+INSERT INTO backfill_checkpoint (modules, start_height, last_height, updated_at) 
+VALUES ($1, $2, $3, $4) 
+ON CONFLICT (modules, start_height) DO UPDATE 
+    SET last_height = excluded.last_height, 
+        updated_at = excluded.updated_at`
+
+	_, err := db.SQL.Exec(stmt, modules, startHeight, lastHeight, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("error while storing backfill checkpoint: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteBackfillCheckpoint deletes the checkpoint of the backfill of the given modules started at the given height
+func (db *Db) DeleteBackfillCheckpoint(modules string, startHeight int64) error {
+	stmt := `DELETE FROM backfill_checkpoint WHERE modules = $1 AND start_height = $2`
+	_, err := db.SQL.Exec(stmt, modules, startHeight)
+	if err != nil {
+		return fmt.Errorf("error while deleting backfill checkpoint: %s", err)
+	}
+
+	return nil
+}
+
+// GetTransactions returns the transactions stored at the given height, indexed by their hash.
+// Since the events and the error code of the transactions are not stored, the returned transactions
+// do not contain any event, and the failed ones only have a non-zero code
+func (db *Db) GetTransactions(height int64, timestamp time.Time) (map[string]*juno.Tx, error) {
+	stmt := `
+SELECT hash, height, success, messages, memo, signatures, signer_infos, fee, 
+       COALESCE(gas_wanted, 0) AS gas_wanted, COALESCE(gas_used, 0) AS gas_used, raw_log, logs
+FROM transaction 
+WHERE height = $1`
+
+	var rows []dbtypes.TransactionRow
+	err := db.Sqlx.Select(&rows, stmt, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting transactions: %s", err)
+	}
+
+	txs := make(map[string]*juno.Tx, len(rows))
+	for _, row := range rows {
+		tx, err := db.toTx(row, timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("error while reading transaction %s: %s", row.Hash, err)
+		}
+		txs[row.Hash] = tx
+	}
+
+	return txs, nil
+}
+
+// toTx converts the given transaction row, stored at the given time, back into a transaction
+func (db *Db) toTx(row dbtypes.TransactionRow, timestamp time.Time) (*juno.Tx, error) {
+	var rawMsgs []json.RawMessage
+	err := json.Unmarshal([]byte(row.Messages), &rawMsgs)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling messages: %s", err)
+	}
+
+	msgs := make([]*codectypes.Any, len(rawMsgs))
+	for index, rawMsg := range rawMsgs {
+		var msg codectypes.Any
+		err = db.Cdc.UnmarshalJSON(rawMsg, &msg)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling message: %s", err)
+		}
+		msgs[index] = &msg
+	}
+
+	var rawSignerInfos []json.RawMessage
+	err = json.Unmarshal([]byte(row.SignerInfos), &rawSignerInfos)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling signer infos: %s", err)
+	}
+
+	signerInfos := make([]*txtypes.SignerInfo, len(rawSignerInfos))
+	for index, rawSignerInfo := range rawSignerInfos {
+		var signerInfo txtypes.SignerInfo
+		err = db.Cdc.UnmarshalJSON(rawSignerInfo, &signerInfo)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling signer info: %s", err)
+		}
+		signerInfos[index] = &signerInfo
+	}
+
+	var fee txtypes.Fee
+	err = db.Cdc.UnmarshalJSON([]byte(row.Fee), &fee)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling fee: %s", err)
+	}
+
+	signatures := make([][]byte, len(row.Signatures))
+	for index, signature := range row.Signatures {
+		signatures[index], err = base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			return nil, fmt.Errorf("error while decoding signature: %s", err)
+		}
+	}
+
+	var logs sdk.ABCIMessageLogs
+	if row.Logs.Valid {
+		err = db.Amino.UnmarshalJSON([]byte(row.Logs.String), &logs)
+		if err != nil {
+			return nil, fmt.Errorf("error while unmarshaling logs: %s", err)
+		}
+	}
+
+	var code uint32
+	if !row.Success {
+		code = 1
+	}
+
+	tx := &txtypes.Tx{
+		Body:       &txtypes.TxBody{Messages: msgs, Memo: row.Memo.String},
+		AuthInfo:   &txtypes.AuthInfo{SignerInfos: signerInfos, Fee: &fee},
+		Signatures: signatures,
+	}
+
+	err = tx.UnpackInterfaces(db.Cdc)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking transaction: %s", err)
+	}
+
+	return juno.NewTx(&sdk.TxResponse{
+		Height:    row.Height,
+		TxHash:    row.Hash,
+		Code:      code,
+		RawLog:    row.RawLog.String,
+		Logs:      logs,
+		GasWanted: row.GasWanted,
+		GasUsed:   row.GasUsed,
+		Timestamp: timestamp.UTC().Format(time.RFC3339),
+	}, tx)
+}
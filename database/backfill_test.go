@@ -0,0 +1,103 @@
+package database_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	juno "github.com/forbole/juno/v5/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_BackfillCheckpoint() {
+	height, err := suite.database.GetBackfillCheckpoint("gov,staking", 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+
+	err = suite.database.SaveBackfillCheckpoint("gov,staking", 100, 150)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBackfillCheckpoint("gov,staking", 100, 200)
+	suite.Require().NoError(err)
+
+	height, err = suite.database.GetBackfillCheckpoint("gov,staking", 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(200), height)
+
+	// Backfills of other modules or started at other heights should have their own checkpoint
+	height, err = suite.database.GetBackfillCheckpoint("staking", 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+
+	height, err = suite.database.GetBackfillCheckpoint("gov,staking", 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+
+	err = suite.database.DeleteBackfillCheckpoint("gov,staking", 100)
+	suite.Require().NoError(err)
+
+	height, err = suite.database.GetBackfillCheckpoint("gov,staking", 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetTransactions() {
+	banktypes.RegisterInterfaces(suite.database.Cdc.(*codec.ProtoCodec).InterfaceRegistry())
+
+	block := suite.getBlock(10)
+	err := suite.database.CreatePartitionIfNotExists("transaction", 0)
+	suite.Require().NoError(err)
+
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		ToAddress:   "cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+	})
+	suite.Require().NoError(err)
+
+	tx, err := juno.NewTx(&sdk.TxResponse{
+		Height:    10,
+		TxHash:    "A5C8D6E1C7B05DB2E4B0E3B2D2D1A3C9E1F2B3C4D5E6F708192A3B4C5D6E7F80",
+		Code:      0,
+		GasWanted: 200000,
+		GasUsed:   150000,
+		RawLog:    "raw log",
+		Logs:      sdk.ABCIMessageLogs{sdk.NewABCIMessageLog(0, "log", nil)},
+	}, &txtypes.Tx{
+		Body: &txtypes.TxBody{Messages: []*codectypes.Any{msg}, Memo: "memo"},
+		AuthInfo: &txtypes.AuthInfo{
+			Fee: &txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000)), GasLimit: 200000},
+		},
+		Signatures: [][]byte{[]byte("signature")},
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveTx(tx)
+	suite.Require().NoError(err)
+
+	txs, err := suite.database.GetTransactions(10, block.Timestamp)
+	suite.Require().NoError(err)
+	suite.Require().Len(txs, 1)
+
+	stored := txs[tx.TxHash]
+	suite.Require().NotNil(stored)
+	suite.Require().True(stored.Successful())
+	suite.Require().Equal("memo", stored.Body.Memo)
+	suite.Require().Equal(tx.AuthInfo.Fee.Amount, stored.AuthInfo.Fee.Amount)
+	suite.Require().Equal(tx.Signatures, stored.Signatures)
+	suite.Require().Equal(int64(200000), stored.GasWanted)
+	suite.Require().Equal(int64(150000), stored.GasUsed)
+	suite.Require().Len(stored.Logs, 1)
+	suite.Require().Equal(block.Timestamp.UTC().Format(time.RFC3339), stored.Timestamp)
+
+	msgs := stored.GetMsgs()
+	suite.Require().Len(msgs, 1)
+	suite.Require().Equal(msg.GetCachedValue(), msgs[0])
+
+	// Heights without transactions should return no transactions
+	txs, err = suite.database.GetTransactions(11, block.Timestamp)
+	suite.Require().NoError(err)
+	suite.Require().Empty(txs)
+}
@@ -1,11 +1,16 @@
 package database
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 )
 
@@ -24,5 +29,121 @@ WHERE supply.height <= excluded.height`
 		return fmt.Errorf("error while storing supply: %s", err)
 	}
 
+	query = `
+INSERT INTO supply_history (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (height) DO UPDATE 
+    SET coins = excluded.coins`
+
+	_, err = db.SQL.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	if err != nil {
+		return fmt.Errorf("error while storing supply history: %s", err)
+	}
+
+	return nil
+}
+
+// GetSupply returns the most recent total supply stored inside the database
+func (db *Db) GetSupply() (sdk.Coins, error) {
+	return getSupply(db.Sqlx)
+}
+
+// GetReplicaSupply returns the most recent total supply using a read replica when possible.
+// Since replicas can be behind the primary database, it should only be used to serve read-only queries
+func (db *Db) GetReplicaSupply() (sdk.Coins, error) {
+	return getSupply(db.LatestReader())
+}
+
+// getSupply returns the most recent total supply stored inside the given database
+func getSupply(reader *sqlx.DB) (sdk.Coins, error) {
+	var rows []dbtypes.SupplyRow
+	err := reader.Select(&rows, `SELECT * FROM supply`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting supply: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return rows[0].Coins.ToCoins(), nil
+}
+
+// SaveCirculatingSupply allows to save for the given height the given circulating amount of coins
+func (db *Db) SaveCirculatingSupply(coins sdk.Coins, height int64) error {
+	query := `
+INSERT INTO circulating_supply (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET coins = excluded.coins,
+    	height = excluded.height
+WHERE circulating_supply.height <= excluded.height`
+
+	_, err := db.SQL.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	if err != nil {
+		return fmt.Errorf("error while storing circulating supply: %s", err)
+	}
+
+	query = `
+INSERT INTO circulating_supply_history (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (height) DO UPDATE 
+    SET coins = excluded.coins`
+
+	_, err = db.SQL.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	if err != nil {
+		return fmt.Errorf("error while storing circulating supply history: %s", err)
+	}
+
 	return nil
 }
+
+// GetCirculatingSupply returns the most recent circulating supply stored inside the database
+func (db *Db) GetCirculatingSupply() (sdk.Coins, error) {
+	return getCirculatingSupply(db.Sqlx)
+}
+
+// GetReplicaCirculatingSupply returns the most recent circulating supply using a read replica when possible.
+// Since replicas can be behind the primary database, it should only be used to serve read-only queries
+func (db *Db) GetReplicaCirculatingSupply() (sdk.Coins, error) {
+	return getCirculatingSupply(db.LatestReader())
+}
+
+// getCirculatingSupply returns the most recent circulating supply stored inside the given database
+func getCirculatingSupply(reader *sqlx.DB) (sdk.Coins, error) {
+	var rows []dbtypes.SupplyRow
+	err := reader.Select(&rows, `SELECT * FROM circulating_supply`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting circulating supply: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return rows[0].Coins.ToCoins(), nil
+}
+
+// SaveBankParams allows to store the given x/bank parameters
+func (db *Db) SaveBankParams(params *types.BankParams) error {
+	paramsBz, err := json.Marshal(&params.Params)
+	if err != nil {
+		return fmt.Errorf("error while marshaling bank params: %s", err)
+	}
+
+	stmt := `
+INSERT INTO bank_params (params, height) 
+VALUES ($1, $2)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET params = excluded.params,
+        height = excluded.height
+WHERE bank_params.height <= excluded.height`
+
+	return db.execBlockOperation(params.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, string(paramsBz), params.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing bank params: %s", err)
+		}
+		return saveParamsHistory(tx, banktypes.ModuleName, paramsBz, params.Height)
+	})
+}
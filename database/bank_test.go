@@ -1,11 +1,15 @@
 package database_test
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	dbtypes "github.com/forbole/callisto/v4/database/types"
 
 	bddbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
 )
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
@@ -74,4 +78,95 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, 1, "supply table should contain only one row")
 	suite.Require().True(expected.Equals(rows[0]))
+
+	// Verify the history
+	var historyRows []bddbtypes.SupplyRow
+	err = suite.database.Sqlx.Select(&historyRows, `SELECT coins, height FROM supply_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRows, 3)
+	suite.Require().Equal(int64(9), historyRows[0].Height)
+	suite.Require().True(dbtypes.NewDbCoins(sdk.NewCoins(sdk.NewCoin("uakash", sdk.NewInt(10)))).Equal(historyRows[1].Coins))
+	suite.Require().Equal(int64(20), historyRows[2].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCirculatingSupply() {
+	// Get the data when nothing is stored
+	stored, err := suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().Nil(stored)
+
+	// Save the data
+	original := sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(10000)))
+	err = suite.database.SaveCirculatingSupply(original, 10)
+	suite.Require().NoError(err)
+
+	// Try updating with a lower height
+	err = suite.database.SaveCirculatingSupply(sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(5000))), 9)
+	suite.Require().NoError(err)
+
+	stored, err = suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().Equal(original, stored)
+
+	// Try updating with a higher height
+	updated := sdk.NewCoins(sdk.NewCoin("udaric", sdk.NewInt(20000)))
+	err = suite.database.SaveCirculatingSupply(updated, 20)
+	suite.Require().NoError(err)
+
+	stored, err = suite.database.GetCirculatingSupply()
+	suite.Require().NoError(err)
+	suite.Require().Equal(updated, stored)
+
+	// Verify the history
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT count(*) FROM circulating_supply_history`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveBankParams() {
+	// Save the data
+	bankParams := banktypes.Params{DefaultSendEnabled: true}
+	err := suite.database.SaveBankParams(types.NewBankParams(bankParams, 10))
+	suite.Require().NoError(err)
+
+	// Verify the data
+	var rows []dbtypes.BankParamsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM bank_params`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	var stored banktypes.Params
+	err = json.Unmarshal([]byte(rows[0].Params), &stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bankParams, stored)
+	suite.Require().Equal(int64(10), rows[0].Height)
+
+	// Try updating with a lower height
+	err = suite.database.SaveBankParams(types.NewBankParams(banktypes.Params{DefaultSendEnabled: false}, 9))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.BankParamsRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM bank_params`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	err = json.Unmarshal([]byte(rows[0].Params), &stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bankParams, stored)
+
+	// Try updating with a higher height
+	bankParams = banktypes.Params{DefaultSendEnabled: false}
+	err = suite.database.SaveBankParams(types.NewBankParams(bankParams, 11))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.BankParamsRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM bank_params`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	err = json.Unmarshal([]byte(rows[0].Params), &stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bankParams, stored)
+	suite.Require().Equal(int64(11), rows[0].Height)
 }
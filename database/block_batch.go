@@ -0,0 +1,327 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/lib/pq"
+)
+
+const (
+	// copyThreshold represents the number of rows starting from which bulk inserts are performed using COPY
+	copyThreshold = 500
+
+	// maxStatementParams represents the maximum number of parameters supported by a single PostgreSQL statement
+	maxStatementParams = 65535
+)
+
+// blockOperation represents a single write operation performed using the given transaction
+type blockOperation func(tx *sql.Tx) error
+
+// BlockBatch represents the unit of work collecting the block row along with all the writes performed by the
+// modules while handling that block, so that they are committed atomically inside a single transaction.
+// If any module fails to handle the block, or the writes cannot be committed, none of the writes is stored.
+// The block row is stored alone instead, and its height is stored as missing so that it is refetched later
+type BlockBatch struct {
+	block      *juno.Block
+	operations []blockOperation
+	failures   []string
+}
+
+// blockBatches contains the block batches that have been started and not yet committed, indexed by height
+type blockBatches struct {
+	mu      sync.Mutex
+	enabled bool
+	batches map[int64]*BlockBatch
+}
+
+// newBlockBatches returns a new empty blockBatches instance
+func newBlockBatches() *blockBatches {
+	return &blockBatches{
+		batches: map[int64]*BlockBatch{},
+	}
+}
+
+// EnableBlockBatches makes every stored block start a new block batch, so that the block along with all the
+// writes performed by the modules while handling it are collected and committed atomically by CommitBlockBatch
+func (db *Db) EnableBlockBatches() {
+	db.blockBatches.mu.Lock()
+	defer db.blockBatches.mu.Unlock()
+	db.blockBatches.enabled = true
+}
+
+// SaveBlock implements database.Database. If batches are enabled, a new block batch is started for the
+// given block, and the block itself is stored only once the batch is committed. Since a new batch replaces
+// any existing one for the same height, the batch left behind by a failed attempt of storing the block
+// is discarded as soon as the block is stored again
+func (db *Db) SaveBlock(block *juno.Block) error {
+	db.blockBatches.mu.Lock()
+	defer db.blockBatches.mu.Unlock()
+
+	if !db.blockBatches.enabled {
+		return db.Database.SaveBlock(block)
+	}
+
+	db.blockBatches.batches[block.Height] = &BlockBatch{block: block}
+	return nil
+}
+
+// saveBlock stores the given block using the given transaction
+func saveBlock(tx *sql.Tx, block *juno.Block) error {
+	stmt := `
+INSERT INTO block (height, hash, num_txs, total_gas, proposer_address, timestamp)
+VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING`
+
+	proposerAddress := sql.NullString{Valid: len(block.ProposerAddress) != 0, String: block.ProposerAddress}
+	_, err := tx.Exec(stmt,
+		block.Height, block.Hash, block.TxNum, block.TotalGas, proposerAddress, block.Timestamp,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing block: %s", err)
+	}
+
+	return nil
+}
+
+// BeginBlockBatch starts a new block batch for the given height. Until the batch is committed, the writes
+// related to the given height are collected instead of being performed immediately.
+// If a batch for the same height already exists, its collected writes are discarded
+func (db *Db) BeginBlockBatch(height int64) {
+	db.blockBatches.mu.Lock()
+	defer db.blockBatches.mu.Unlock()
+	db.blockBatches.batches[height] = &BlockBatch{}
+}
+
+// FailBlockBatch marks the block batch of the given height as failed because of the given error,
+// so that its writes are never committed. If no batch exists for the given height, nothing is done
+func (db *Db) FailBlockBatch(height int64, err error) {
+	db.blockBatches.mu.Lock()
+	defer db.blockBatches.mu.Unlock()
+
+	if batch, found := db.blockBatches.batches[height]; found {
+		batch.failures = append(batch.failures, err.Error())
+	}
+}
+
+// CommitBlockBatch performs all the writes collected by the block batch of the given height inside a single
+// transaction, so that either all of them succeed or none does. If no batch exists for the given height,
+// nothing is done.
+// If the batch has failed or its writes cannot be committed, an error is returned and only its block is stored,
+// along with its height as a missing block so that it is refetched later
+func (db *Db) CommitBlockBatch(height int64) error {
+	db.blockBatches.mu.Lock()
+	batch, found := db.blockBatches.batches[height]
+	delete(db.blockBatches.batches, height)
+	db.blockBatches.mu.Unlock()
+
+	if !found {
+		return nil
+	}
+
+	if len(batch.failures) == 0 {
+		operations := batch.operations
+		if batch.block != nil {
+			// The block is stored first since the other writes might reference it
+			operations = append([]blockOperation{func(tx *sql.Tx) error {
+				return saveBlock(tx, batch.block)
+			}}, operations...)
+		}
+
+		err := db.execOperations(operations)
+		if err == nil {
+			return nil
+		}
+
+		batch.failures = append(batch.failures, err.Error())
+	}
+
+	reason := strings.Join(batch.failures, "; ")
+	if batch.block != nil {
+		err := db.execOperations([]blockOperation{
+			func(tx *sql.Tx) error {
+				return saveBlock(tx, batch.block)
+			},
+			func(tx *sql.Tx) error {
+				return saveIncompleteBlock(tx, height, reason, time.Now().UTC())
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error while storing incomplete block at height %d: %s", height, err)
+		}
+	}
+
+	return fmt.Errorf("error while committing block batch at height %d: %s", height, reason)
+}
+
+// DiscardBlockBatch discards the block batch of the given height along with all its collected writes
+func (db *Db) DiscardBlockBatch(height int64) {
+	db.blockBatches.mu.Lock()
+	defer db.blockBatches.mu.Unlock()
+	delete(db.blockBatches.batches, height)
+}
+
+// execBlockOperation adds the given operation to the block batch of the given height if it exists,
+// or performs it immediately otherwise.
+// Operations storing data that is read back while handling the same block should not be added to
+// a batch, since their result would not be visible until the batch is committed
+func (db *Db) execBlockOperation(height int64, operation blockOperation) error {
+	db.blockBatches.mu.Lock()
+	batch, found := db.blockBatches.batches[height]
+	if found {
+		// The operation is added while holding the lock so that it can never be added to a batch
+		// that is already being committed
+		batch.operations = append(batch.operations, operation)
+	}
+	db.blockBatches.mu.Unlock()
+
+	if found {
+		return nil
+	}
+
+	return db.execOperations([]blockOperation{operation})
+}
+
+// execOperations performs all the given operations inside a single transaction
+func (db *Db) execOperations(operations []blockOperation) error {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	tx, err := db.SQL.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	for _, operation := range operations {
+		err = operation(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// bulkInsert represents the insertion of multiple rows inside the same table
+type bulkInsert struct {
+	table    string
+	columns  []string
+	rows     [][]interface{}
+	conflict string
+}
+
+// newBulkInsert returns a new bulkInsert inserting rows having the given columns inside the given table,
+// and solving the conflicts using the given ON CONFLICT clause
+func newBulkInsert(table string, columns []string, conflict string) *bulkInsert {
+	return &bulkInsert{
+		table:    table,
+		columns:  columns,
+		conflict: conflict,
+	}
+}
+
+// add adds a new row having the given values, which must be in the same order as the columns
+func (b *bulkInsert) add(values ...interface{}) {
+	b.rows = append(b.rows, values)
+}
+
+// exec performs the insertion using the given transaction. Large batches are copied inside a temporary table
+// and then inserted from there, while smaller ones are inserted using multi-row INSERT statements
+func (b *bulkInsert) exec(tx *sql.Tx) error {
+	if len(b.rows) == 0 {
+		return nil
+	}
+
+	if len(b.rows) >= copyThreshold {
+		return b.copy(tx)
+	}
+
+	return b.insert(tx)
+}
+
+// insert performs the insertion using multi-row INSERT statements,
+// splitting the rows so that each statement does not exceed the maximum number of parameters
+func (b *bulkInsert) insert(tx *sql.Tx) error {
+	chunkSize := maxStatementParams / len(b.columns)
+	for start := 0; start < len(b.rows); start += chunkSize {
+		end := start + chunkSize
+		if end > len(b.rows) {
+			end = len(b.rows)
+		}
+
+		stmt := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", b.table, strings.Join(b.columns, ", "))
+		var params []interface{}
+
+		for i, row := range b.rows[start:end] {
+			placeholders := make([]string, len(row))
+			for j := range row {
+				placeholders[j] = fmt.Sprintf("$%d", i*len(b.columns)+j+1)
+			}
+
+			stmt += fmt.Sprintf("(%s),", strings.Join(placeholders, ", "))
+			params = append(params, row...)
+		}
+
+		stmt = stmt[:len(stmt)-1] // Remove trailing ","
+		stmt += "\n" + b.conflict
+
+		_, err := tx.Exec(stmt, params...)
+		if err != nil {
+			return fmt.Errorf("error while inserting into %s: %s", b.table, err)
+		}
+	}
+
+	return nil
+}
+
+// copy performs the insertion by copying all the rows inside a temporary table using COPY,
+// and inserting them from there so that the conflicts are solved as for the other inserts
+func (b *bulkInsert) copy(tx *sql.Tx) error {
+	tempTable := fmt.Sprintf("%s_bulk_insert", b.table)
+	columns := strings.Join(b.columns, ", ")
+
+	_, err := tx.Exec(fmt.Sprintf(`CREATE TEMP TABLE %s ON COMMIT DROP AS SELECT %s FROM %s WITH NO DATA`,
+		tempTable, columns, b.table))
+	if err != nil {
+		return fmt.Errorf("error while creating %s temporary table: %s", b.table, err)
+	}
+
+	copyStmt, err := tx.Prepare(pq.CopyIn(tempTable, b.columns...))
+	if err != nil {
+		return fmt.Errorf("error while preparing %s copy: %s", b.table, err)
+	}
+	defer copyStmt.Close()
+
+	for _, row := range b.rows {
+		_, err = copyStmt.Exec(row...)
+		if err != nil {
+			return fmt.Errorf("error while copying into %s: %s", b.table, err)
+		}
+	}
+
+	// Flush the copied rows
+	_, err = copyStmt.Exec()
+	if err != nil {
+		return fmt.Errorf("error while copying into %s: %s", b.table, err)
+	}
+
+	stmt := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s\n%s", b.table, columns, columns, tempTable, b.conflict)
+	_, err = tx.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error while inserting into %s: %s", b.table, err)
+	}
+
+	_, err = tx.Exec(fmt.Sprintf(`DROP TABLE %s`, tempTable))
+	if err != nil {
+		return fmt.Errorf("error while dropping %s temporary table: %s", b.table, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package database_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_CommitBlockBatch() {
+	_ = suite.getBlock(10)
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	suite.database.BeginBlockBatch(10)
+
+	err := suite.database.SaveValidatorsVotingPowers([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	// The writes should not be visible until the batch is committed
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_voting_power`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+
+	err = suite.database.CommitBlockBatch(10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_voting_power`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	// Discarded batches should never be stored
+	suite.database.BeginBlockBatch(10)
+	err = suite.database.SaveValidatorsStatuses([]types.ValidatorStatus{
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 10),
+	})
+	suite.Require().NoError(err)
+	suite.database.DiscardBlockBatch(10)
+
+	err = suite.database.CommitBlockBatch(10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_status`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveBlock_BlockBatch() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	suite.database.EnableBlockBatches()
+
+	block := juno.NewBlock(10, "hash", 0, 0, validator.GetConsAddr(), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	err := suite.database.SaveBlock(block)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsVotingPowers([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	// The block should not be stored until its batch is committed
+	exists, err := suite.database.HasBlock(10)
+	suite.Require().NoError(err)
+	suite.Require().False(exists)
+
+	// Storing the block again after a failure should discard the writes of the previous attempt
+	err = suite.database.SaveBlock(block)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsStatuses([]types.ValidatorStatus{
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.CommitBlockBatch(10)
+	suite.Require().NoError(err)
+
+	exists, err = suite.database.HasBlock(10)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_voting_power`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_status`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	// Writes performed once the batch has been committed should be stored immediately
+	err = suite.database.SaveValidatorsVotingPowers([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower(validator.GetConsAddr(), 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_voting_power`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_CommitBlockBatch_CommitFailure() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	suite.database.EnableBlockBatches()
+
+	block := juno.NewBlock(10, "hash", 0, 0, validator.GetConsAddr(), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	err := suite.database.SaveBlock(block)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsStatuses([]types.ValidatorStatus{
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 10),
+	})
+	suite.Require().NoError(err)
+
+	// The voting power of an unknown validator cannot be stored
+	err = suite.database.SaveValidatorsVotingPowers([]types.ValidatorVotingPower{
+		types.NewValidatorVotingPower("cosmosvalcons1unknown", 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.CommitBlockBatch(10)
+	suite.Require().Error(err)
+
+	// The block should be stored without any of the writes of its batch
+	exists, err := suite.database.HasBlock(10)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_status`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+
+	// The block should be refetched
+	rows, err := suite.database.GetDueMissingBlocks(time.Now().UTC(), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(int64(10), rows[0].Height)
+	suite.Require().True(rows[0].LastError.Valid)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_CommitBlockBatch_ModuleFailure() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	suite.database.EnableBlockBatches()
+
+	block := juno.NewBlock(10, "hash", 0, 0, validator.GetConsAddr(), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	err := suite.database.SaveBlock(block)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveValidatorsStatuses([]types.ValidatorStatus{
+		types.NewValidatorStatus(validator.GetConsAddr(), validator.GetConsPubKey(), 3, false, 10),
+	})
+	suite.Require().NoError(err)
+
+	suite.database.FailBlockBatch(10, errors.New("error while handling block"))
+
+	err = suite.database.CommitBlockBatch(10)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "error while handling block")
+
+	// The block should be stored without any of the writes of its batch
+	exists, err := suite.database.HasBlock(10)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_status`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+
+	// The block should be refetched
+	rows, err := suite.database.GetDueMissingBlocks(time.Now().UTC(), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(int64(10), rows[0].Height)
+	suite.Require().Equal("error while handling block", rows[0].LastError.String)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorsStatuses_Copy() {
+	_ = suite.getBlock(10)
+
+	// Enough statuses to be stored using COPY
+	var statuses []types.ValidatorStatus
+	for i := 0; i < 600; i++ {
+		statuses = append(statuses, types.NewValidatorStatus(
+			fmt.Sprintf("cosmosvalcons%d", i), fmt.Sprintf("cosmosvalconspub%d", i), 3, false, 10,
+		))
+	}
+
+	err := suite.database.SaveValidatorsStatuses(statuses)
+	suite.Require().NoError(err)
+
+	// Storing them again should update the existing rows
+	for i := range statuses {
+		statuses[i].Jailed = true
+	}
+	err = suite.database.SaveValidatorsStatuses(statuses)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator_status WHERE jailed = true`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(600, count)
+
+	// The block proposer is stored as well
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM validator`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(601, count)
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -41,117 +42,123 @@ func (db *Db) GetLastBlockHeightAndTimestamp() (dbtypes.BlockHeightAndTimestamp,
 	return blockHeightAndTimestamp[0], nil
 }
 
-// -------------------------------------------------------------------------------------------------------------------
-
-// getBlockHeightTime retrieves the block at the specific time
-func (db *Db) getBlockHeightTime(pastTime time.Time) (dbtypes.BlockRow, error) {
-	stmt := `SELECT * FROM block WHERE block.timestamp <= $1 ORDER BY block.timestamp DESC LIMIT 1;`
+// GetFirstBlockHeightAndTimestamp returns the first block height and timestamp stored inside the database
+func (db *Db) GetFirstBlockHeightAndTimestamp() (dbtypes.BlockHeightAndTimestamp, error) {
+	stmt := `SELECT height, timestamp FROM block ORDER BY height ASC LIMIT 1`
 
-	var val []dbtypes.BlockRow
-	if err := db.Sqlx.Select(&val, stmt, pastTime); err != nil {
-		return dbtypes.BlockRow{}, err
+	var blockHeightAndTimestamp []dbtypes.BlockHeightAndTimestamp
+	if err := db.Sqlx.Select(&blockHeightAndTimestamp, stmt); err != nil {
+		return dbtypes.BlockHeightAndTimestamp{}, fmt.Errorf("cannot get first block height and timestamp from db: %s", err)
 	}
 
-	if len(val) == 0 {
-		return dbtypes.BlockRow{}, fmt.Errorf("cannot get block time, no blocks saved")
+	if len(blockHeightAndTimestamp) == 0 {
+		return dbtypes.BlockHeightAndTimestamp{}, nil
 	}
 
-	return val[0], nil
-}
-
-// GetBlockHeightTimeMinuteAgo return block height and time that a block proposals
-// about a minute ago from input date
-func (db *Db) GetBlockHeightTimeMinuteAgo(now time.Time) (dbtypes.BlockRow, error) {
-	pastTime := now.Add(time.Minute * -1)
-	return db.getBlockHeightTime(pastTime)
-}
-
-// GetBlockHeightTimeHourAgo return block height and time that a block proposals
-// about a hour ago from input date
-func (db *Db) GetBlockHeightTimeHourAgo(now time.Time) (dbtypes.BlockRow, error) {
-	pastTime := now.Add(time.Hour * -1)
-	return db.getBlockHeightTime(pastTime)
-}
-
-// GetBlockHeightTimeDayAgo return block height and time that a block proposals
-// about a day (24hour) ago from input date
-func (db *Db) GetBlockHeightTimeDayAgo(now time.Time) (dbtypes.BlockRow, error) {
-	pastTime := now.Add(time.Hour * -24)
-	return db.getBlockHeightTime(pastTime)
+	return blockHeightAndTimestamp[0], nil
 }
 
 // -------------------------------------------------------------------------------------------------------------------
 
-// SaveAverageBlockTimePerMin save the average block time in average_block_time_per_minute table
-func (db *Db) SaveAverageBlockTimePerMin(averageTime float64, height int64) error {
+// SaveAverageBlockTimeGenesis save the average block time in average_block_time_from_genesis table
+func (db *Db) SaveAverageBlockTimeGenesis(averageTime float64, height int64) error {
 	stmt := `
-INSERT INTO average_block_time_per_minute(average_time, height) 
+INSERT INTO average_block_time_from_genesis(average_time ,height) 
 VALUES ($1, $2) 
 ON CONFLICT (one_row_id) DO UPDATE 
     SET average_time = excluded.average_time, 
         height = excluded.height
-WHERE average_block_time_per_minute.height <= excluded.height`
-
-	_, err := db.Sqlx.Exec(stmt, averageTime, height)
-	if err != nil {
-		return fmt.Errorf("error while storing average block time per minute: %s", err)
-	}
+WHERE average_block_time_from_genesis.height <= excluded.height`
 
-	return nil
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, averageTime, height)
+		if err != nil {
+			return fmt.Errorf("error while storing average block time since genesis: %s", err)
+		}
+		return nil
+	})
 }
 
-// SaveAverageBlockTimePerHour save the average block time in average_block_time_per_hour table
-func (db *Db) SaveAverageBlockTimePerHour(averageTime float64, height int64) error {
-	stmt := `
-INSERT INTO average_block_time_per_hour(average_time, height) 
-VALUES ($1, $2) 
-ON CONFLICT (one_row_id) DO UPDATE 
-    SET average_time = excluded.average_time,
-        height = excluded.height
-WHERE average_block_time_per_hour.height <= excluded.height`
+// GetLastBlockTimeBucket returns the start time of the latest block time bucket of the given period
+// stored inside the database, or nil if no bucket is found
+func (db *Db) GetLastBlockTimeBucket(period string) (*time.Time, error) {
+	stmt := `SELECT bucket FROM block_time_series WHERE period = $1 ORDER BY bucket DESC LIMIT 1`
 
-	_, err := db.Sqlx.Exec(stmt, averageTime, height)
-	if err != nil {
-		return fmt.Errorf("error while storing average block time per hour: %s", err)
+	var buckets []time.Time
+	if err := db.LatestReader().Select(&buckets, stmt, period); err != nil {
+		return nil, fmt.Errorf("error while getting last block time bucket: %s", err)
 	}
 
-	return nil
-}
-
-// SaveAverageBlockTimePerDay save the average block time in average_block_time_per_day table
-func (db *Db) SaveAverageBlockTimePerDay(averageTime float64, height int64) error {
-	stmt := `
-INSERT INTO average_block_time_per_day(average_time, height) 
-VALUES ($1, $2)
-ON CONFLICT (one_row_id) DO UPDATE 
-    SET average_time = excluded.average_time,
-        height = excluded.height
-WHERE average_block_time_per_day.height <= excluded.height`
-
-	_, err := db.Sqlx.Exec(stmt, averageTime, height)
-	if err != nil {
-		return fmt.Errorf("error while storing average block time per day: %s", err)
+	if len(buckets) == 0 {
+		return nil, nil
 	}
 
-	return nil
+	return &buckets[0], nil
 }
 
-// SaveAverageBlockTimeGenesis save the average block time in average_block_time_from_genesis table
-func (db *Db) SaveAverageBlockTimeGenesis(averageTime float64, height int64) error {
+// SaveBlockTimeBuckets computes the block time buckets of the given period from the blocks stored
+// inside the database having a timestamp within the given time range, and stores them.
+// The block time is only computed between consecutive heights so that missing blocks do not alter the results.
+// The buckets are computed using a read replica when one has already stored the given height
+func (db *Db) SaveBlockTimeBuckets(period string, from, to time.Time, height int64) error {
 	stmt := `
-INSERT INTO average_block_time_from_genesis(average_time ,height) 
-VALUES ($1, $2) 
-ON CONFLICT (one_row_id) DO UPDATE 
-    SET average_time = excluded.average_time, 
-        height = excluded.height
-WHERE average_block_time_from_genesis.height <= excluded.height`
-
-	_, err := db.Sqlx.Exec(stmt, averageTime, height)
+SELECT date_trunc($1, block_time.timestamp) AS bucket, 
+       COUNT(*) AS blocks_count,
+       MIN(block_time.time) AS min_block_time,
+       MAX(block_time.time) AS max_block_time,
+       AVG(block_time.time) AS average_block_time,
+       percentile_cont(0.95) WITHIN GROUP (ORDER BY block_time.time) AS p95_block_time,
+       MIN(block_time.height) AS first_height,
+       MAX(block_time.height) AS last_height
+FROM (
+    SELECT height, 
+           timestamp,
+           EXTRACT(EPOCH FROM timestamp - LAG(timestamp) OVER (ORDER BY height)) AS time,
+           height - LAG(height) OVER (ORDER BY height) AS height_diff
+    FROM block
+    WHERE height >= COALESCE((SELECT height FROM block WHERE timestamp < $2 ORDER BY timestamp DESC LIMIT 1), 0)
+      AND timestamp < $3
+) AS block_time
+WHERE block_time.timestamp >= $2 AND block_time.height_diff = 1
+GROUP BY bucket`
+
+	var rows []dbtypes.BlockTimeSeriesRow
+	err := db.Reader(height).Select(&rows, stmt, period, from, to)
 	if err != nil {
-		return fmt.Errorf("error while storing average block time since genesis: %s", err)
+		return fmt.Errorf("error while computing block time buckets: %s", err)
 	}
 
-	return nil
+	insert := newBulkInsert(
+		"block_time_series",
+		[]string{
+			"period", "bucket", "blocks_count", "min_block_time", "max_block_time", "average_block_time",
+			"p95_block_time", "first_height", "last_height",
+		},
+		`ON CONFLICT (period, bucket) DO UPDATE 
+    SET blocks_count = excluded.blocks_count,
+        min_block_time = excluded.min_block_time,
+        max_block_time = excluded.max_block_time,
+        average_block_time = excluded.average_block_time,
+        p95_block_time = excluded.p95_block_time,
+        first_height = excluded.first_height,
+        last_height = excluded.last_height`,
+	)
+	for _, row := range rows {
+		insert.add(
+			period, row.Bucket, row.BlocksCount, row.MinBlockTime, row.MaxBlockTime, row.AverageBlockTime,
+			row.P95BlockTime, row.FirstHeight, row.LastHeight,
+		)
+	}
+
+	return db.execOperations([]blockOperation{
+		func(tx *sql.Tx) error {
+			err := insert.exec(tx)
+			if err != nil {
+				return fmt.Errorf("error while storing block time buckets: %s", err)
+			}
+			return nil
+		},
+	})
 }
 
 // -------------------------------------------------------------------------------------------------------------------
@@ -182,9 +189,146 @@ func (db *Db) GetGenesis() (*types.Genesis, error) {
 	}
 
 	if len(rows) == 0 {
-		return nil, fmt.Errorf("no rows inside the genesis table")
+		return nil, nil
 	}
 
 	row := rows[0]
 	return types.NewGenesis(row.ChainID, row.Time, row.InitialHeight), nil
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// GetBlock returns the block stored inside the database at the given height, or nil if no block is found
+func (db *Db) GetBlock(height int64) (*dbtypes.BlockRow, error) {
+	stmt := `SELECT * FROM block WHERE height = $1`
+
+	var blocks []dbtypes.BlockRow
+	if err := db.Sqlx.Select(&blocks, stmt, height); err != nil {
+		return nil, fmt.Errorf("error while getting block: %s", err)
+	}
+
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	return &blocks[0], nil
+}
+
+// SaveProposerBlockStats adds the given statistics of the block at the given height to the daily proposer statistics.
+// Blocks that have already been included are ignored, so that the same height can be handled more than once
+func (db *Db) SaveProposerBlockStats(height int64, stats []types.ProposerBlockStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO proposer_daily_stats (
+    validator_address, date, blocks_proposed, expected_proposals, empty_blocks, total_gas, average_gas, height
+) 
+VALUES `
+
+	var params []interface{}
+	for i, stat := range stats {
+		si := i * 8
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d),",
+			si+1, si+2, si+3, si+4, si+5, si+6, si+7, si+8)
+
+		var averageGas int64
+		if stat.BlocksProposed > 0 {
+			averageGas = stat.TotalGas / stat.BlocksProposed
+		}
+
+		params = append(params,
+			stat.ValidatorAddress,
+			stat.Date,
+			stat.BlocksProposed,
+			stat.ExpectedProposals.String(),
+			stat.EmptyBlocks,
+			stat.TotalGas,
+			averageGas,
+			stat.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (validator_address, date) DO UPDATE 
+    SET blocks_proposed = proposer_daily_stats.blocks_proposed + excluded.blocks_proposed,
+        expected_proposals = proposer_daily_stats.expected_proposals + excluded.expected_proposals,
+        empty_blocks = proposer_daily_stats.empty_blocks + excluded.empty_blocks,
+        total_gas = proposer_daily_stats.total_gas + excluded.total_gas,
+        average_gas = (proposer_daily_stats.total_gas + excluded.total_gas)::DECIMAL / 
+            GREATEST(proposer_daily_stats.blocks_proposed + excluded.blocks_proposed, 1),
+        height = GREATEST(proposer_daily_stats.height, excluded.height)`
+
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		res, err := tx.Exec(`INSERT INTO proposer_stats_block (height) VALUES ($1) ON CONFLICT DO NOTHING`, height)
+		if err != nil {
+			return fmt.Errorf("error while storing proposer stats block: %s", err)
+		}
+
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error while getting proposer stats block rows: %s", err)
+		}
+
+		// Skip if the block has already been included
+		if inserted == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(stmt, params...)
+		if err != nil {
+			return fmt.Errorf("error while storing proposer daily stats: %s", err)
+		}
+
+		return nil
+	})
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveValidatorSetChanges stores the given validator set changes inside the database
+func (db *Db) SaveValidatorSetChanges(changes []types.ValidatorSetChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	insert := newBulkInsert(
+		"validator_set_change",
+		[]string{
+			"validator_address", "type", "previous_voting_power", "voting_power", "voting_power_delta", "status",
+			"jailed", "height", "timestamp",
+		},
+		`ON CONFLICT (validator_address, height) DO UPDATE 
+    SET type = excluded.type,
+        previous_voting_power = excluded.previous_voting_power,
+        voting_power = excluded.voting_power,
+        voting_power_delta = excluded.voting_power_delta,
+        status = excluded.status,
+        jailed = excluded.jailed,
+        timestamp = excluded.timestamp`,
+	)
+
+	for _, change := range changes {
+		insert.add(
+			change.ValidatorAddress,
+			change.Type,
+			change.PreviousVotingPower,
+			change.VotingPower,
+			change.VotingPowerDelta(),
+			change.Status,
+			change.Jailed,
+			change.Height,
+			change.Timestamp,
+		)
+	}
+
+	return db.execBlockOperation(changes[0].Height, func(tx *sql.Tx) error {
+		err := insert.exec(tx)
+		if err != nil {
+			return fmt.Errorf("error while storing validator set changes: %s", err)
+		}
+		return nil
+	})
+}
@@ -1,251 +1,15 @@
 package database_test
 
 import (
+	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	dbtypes "github.com/forbole/callisto/v4/database/types"
 	"github.com/forbole/callisto/v4/types"
 )
 
-func (suite *DbTestSuite) TestSaveConsensus_GetBlockHeightTimeMinuteAgo() {
-	timeAgo, err := time.Parse(time.RFC3339, "2020-01-01T15:00:00Z")
-	suite.Require().NoError(err)
-
-	var height int64 = 1000
-
-	_, err = suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
-	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
-	suite.Require().NoError(err)
-
-	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
-	VALUES ($1, '5EF85F2251F656BA0FBFED9AEFCBC44A9CCBCFD8B96897E74426E07229D2ADE0', '0', '0', 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $2)`, height, timeAgo)
-	suite.Require().NoError(err)
-
-	timeNow := timeAgo.Add(time.Minute)
-	result, err := suite.database.GetBlockHeightTimeMinuteAgo(timeNow)
-	suite.Require().NoError(err)
-
-	suite.Require().True(result.Timestamp.Equal(timeAgo))
-	suite.Require().Equal(height, result.Height)
-}
-
-func (suite *DbTestSuite) TestSaveConsensus_GetBlockHeightTimeHourAgo() {
-	timeAgo, err := time.Parse(time.RFC3339, "2020-01-01T15:00:00Z")
-	suite.Require().NoError(err)
-
-	var height int64 = 1000
-	_, err = suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
-	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
-	suite.Require().NoError(err)
-
-	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
-	VALUES ($1, '5EF85F2251F656BA0FBFED9AEFCBC44A9CCBCFD8B96897E74426E07229D2ADE0', '0', '0', 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $2)`, height, timeAgo)
-	suite.Require().NoError(err)
-
-	timeNow := timeAgo.Add(time.Hour)
-	result, err := suite.database.GetBlockHeightTimeHourAgo(timeNow)
-	suite.Require().NoError(err)
-
-	suite.Require().True(result.Timestamp.Equal(timeAgo))
-	suite.Require().Equal(height, result.Height)
-}
-
-func (suite *DbTestSuite) TestSaveConsensus_GetBlockHeightTimeDayAgo() {
-	timeAgo, err := time.Parse(time.RFC3339, "2020-01-01T15:00:00Z")
-	suite.Require().NoError(err)
-
-	var height int64 = 1000
-
-	_, err = suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
-	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
-	suite.Require().NoError(err)
-
-	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
-	VALUES ($1, '5EF85F2251F656BA0FBFED9AEFCBC44A9CCBCFD8B96897E74426E07229D2ADE0', '0', '0', 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $2)`, height, timeAgo)
-	suite.Require().NoError(err)
-
-	timeNow := timeAgo.Add(time.Hour * 24)
-	result, err := suite.database.GetBlockHeightTimeDayAgo(timeNow)
-	suite.Require().NoError(err)
-
-	suite.Require().True(result.Timestamp.Equal(timeAgo))
-	suite.Require().Equal(height, result.Height)
-}
-
-func (suite *DbTestSuite) TestSaveConsensus_SaveAverageBlockTimePerMin() {
-	// Save the data
-	err := suite.database.SaveAverageBlockTimePerMin(5.05, 10)
-	suite.Require().NoError(err)
-
-	original := dbtypes.NewAverageTimeRow(5.05, 10)
-
-	// Verify the data
-	var rows []dbtypes.AverageTimeRow
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_minute")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(original))
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with a lower height
-	err = suite.database.SaveAverageBlockTimePerMin(6, 9)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_minute")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(original), "updating with a lower height should not change the data")
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with the same height
-	err = suite.database.SaveAverageBlockTimePerMin(10, 10)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	expected := dbtypes.NewAverageTimeRow(10, 10)
-
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_minute")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(expected), "updating with same height should change the data")
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with a higher height
-	err = suite.database.SaveAverageBlockTimePerMin(20, 15)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	expected = dbtypes.NewAverageTimeRow(20, 15)
-
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_minute")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(expected), "updating with higher height should change the data")
-}
-
-func (suite *DbTestSuite) TestSaveConsensus_SaveAverageBlockTimePerHour() {
-	// Save the data
-	err := suite.database.SaveAverageBlockTimePerHour(5.05, 10)
-	suite.Require().NoError(err)
-
-	original := dbtypes.NewAverageTimeRow(5.05, 10)
-
-	// Verify the data
-	var rows []dbtypes.AverageTimeRow
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_hour")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(original))
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with a lower height
-	err = suite.database.SaveAverageBlockTimePerHour(6, 9)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_hour")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(original), "updating with a lower height should not change the data")
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with the same height
-	err = suite.database.SaveAverageBlockTimePerHour(10, 10)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	expected := dbtypes.NewAverageTimeRow(10, 10)
-
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_hour")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(expected), "updating with same height should change the data")
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with a higher height
-	err = suite.database.SaveAverageBlockTimePerHour(20, 15)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	expected = dbtypes.NewAverageTimeRow(20, 15)
-
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_hour")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(expected), "updating with higher height should change the data")
-}
-
-func (suite *DbTestSuite) TestSaveConsensus_SaveAverageBlockTimePerDay() {
-	// Save the data
-	err := suite.database.SaveAverageBlockTimePerDay(5.05, 10)
-	suite.Require().NoError(err)
-
-	original := dbtypes.NewAverageTimeRow(5.05, 10)
-
-	// Verify the data
-	var rows []dbtypes.AverageTimeRow
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_day")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(original))
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with a lower height
-	err = suite.database.SaveAverageBlockTimePerDay(6, 9)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_day")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(original), "updating with a lower height should not change the data")
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with the same height
-	err = suite.database.SaveAverageBlockTimePerDay(10, 10)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	expected := dbtypes.NewAverageTimeRow(10, 10)
-
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_day")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(expected), "updating with same height should change the data")
-
-	// ----------------------------------------------------------------------------------------------------------------
-
-	// Try updating with a higher height
-	err = suite.database.SaveAverageBlockTimePerDay(20, 15)
-	suite.Require().NoError(err)
-
-	// Verify the data
-	expected = dbtypes.NewAverageTimeRow(20, 15)
-
-	rows = []dbtypes.AverageTimeRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM average_block_time_per_day")
-	suite.Require().NoError(err)
-	suite.Require().Len(rows, 1)
-	suite.Require().True(rows[0].Equal(expected), "updating with higher height should change the data")
-}
-
 func (suite *DbTestSuite) TestSaveConsensus_SaveAverageBlockTimeGenesis() {
 	// Save the data
 	err := suite.database.SaveAverageBlockTimeGenesis(5.05, 10)
@@ -329,6 +93,12 @@ func (suite *DbTestSuite) TestSaveConsensus_SaveGenesisData() {
 	)))
 }
 
+func (suite *DbTestSuite) TestSaveConsensus_GetGenesis_Empty() {
+	genesis, err := suite.database.GetGenesis()
+	suite.Require().NoError(err)
+	suite.Require().Nil(genesis)
+}
+
 func (suite *DbTestSuite) TestSaveConsensus_GetGenesis() {
 	_, err := suite.database.Sqlx.Exec(
 		`INSERT INTO genesis(chain_id, time, initial_height) VALUES ($1, $2, $3)`,
@@ -345,3 +115,139 @@ func (suite *DbTestSuite) TestSaveConsensus_GetGenesis() {
 		0,
 	)))
 }
+
+func (suite *DbTestSuite) TestSaveConsensus_SaveProposerBlockStats() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveProposerBlockStats(10, []types.ProposerBlockStats{
+		types.NewProposerBlockStats(validator.GetConsAddr(), date, 1, sdk.NewDecWithPrec(5, 1), 0, 1000, 10),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveProposerBlockStats(11, []types.ProposerBlockStats{
+		types.NewProposerBlockStats(validator.GetConsAddr(), date, 1, sdk.NewDecWithPrec(5, 1), 1, 0, 11),
+	})
+	suite.Require().NoError(err)
+
+	// Handling the same height twice should not change the data
+	err = suite.database.SaveProposerBlockStats(11, []types.ProposerBlockStats{
+		types.NewProposerBlockStats(validator.GetConsAddr(), date, 1, sdk.NewDecWithPrec(5, 1), 1, 0, 11),
+	})
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		BlocksProposed    int64   `db:"blocks_proposed"`
+		ExpectedProposals float64 `db:"expected_proposals"`
+		EmptyBlocks       int64   `db:"empty_blocks"`
+		TotalGas          int64   `db:"total_gas"`
+		AverageGas        float64 `db:"average_gas"`
+		Height            int64   `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT blocks_proposed, expected_proposals, empty_blocks, total_gas, average_gas, height FROM proposer_daily_stats`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(int64(2), rows[0].BlocksProposed)
+	suite.Require().Equal(float64(1), rows[0].ExpectedProposals)
+	suite.Require().Equal(int64(1), rows[0].EmptyBlocks)
+	suite.Require().Equal(int64(1000), rows[0].TotalGas)
+	suite.Require().Equal(float64(500), rows[0].AverageGas)
+	suite.Require().Equal(int64(11), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestSaveConsensus_SaveValidatorSetChanges() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveValidatorSetChanges([]types.ValidatorSetChange{
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeEntered, 0, 10, 3, false, 10, timestamp),
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeLeft, 10, 0, 2, true, 20, timestamp),
+	})
+	suite.Require().NoError(err)
+
+	// Saving the same change twice should not add a new row
+	err = suite.database.SaveValidatorSetChanges([]types.ValidatorSetChange{
+		types.NewValidatorSetChange(validator.GetConsAddr(), types.ValidatorSetChangeLeft, 10, 0, 2, true, 20, timestamp),
+	})
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		Type             string `db:"type"`
+		VotingPowerDelta int64  `db:"voting_power_delta"`
+		Jailed           bool   `db:"jailed"`
+		Height           int64  `db:"height"`
+	}
+	err = suite.database.Sqlx.Select(&rows,
+		`SELECT type, voting_power_delta, jailed, height FROM validator_set_change ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(types.ValidatorSetChangeEntered, rows[0].Type)
+	suite.Require().Equal(int64(10), rows[0].VotingPowerDelta)
+	suite.Require().Equal(types.ValidatorSetChangeLeft, rows[1].Type)
+	suite.Require().Equal(int64(-10), rows[1].VotingPowerDelta)
+	suite.Require().True(rows[1].Jailed)
+}
+
+func (suite *DbTestSuite) TestSaveConsensus_SaveBlockTimeBuckets() {
+	_, err := suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
+	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
+	suite.Require().NoError(err)
+
+	// Store blocks produced every 5 seconds, with height 5 missing
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 20; height++ {
+		if height == 5 {
+			continue
+		}
+
+		_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
+	VALUES ($1, $2, '0', '0', 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $3)`,
+			height, fmt.Sprintf("hash-%d", height), start.Add(time.Duration(height*5)*time.Second))
+		suite.Require().NoError(err)
+	}
+
+	bucket, err := suite.database.GetLastBlockTimeBucket(types.BlockTimePeriodMinute)
+	suite.Require().NoError(err)
+	suite.Require().Nil(bucket)
+
+	err = suite.database.SaveBlockTimeBuckets(types.BlockTimePeriodMinute, start, start.Add(24*time.Hour), 20)
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		Bucket           time.Time `db:"bucket"`
+		BlocksCount      int64     `db:"blocks_count"`
+		AverageBlockTime float64   `db:"average_block_time"`
+		FirstHeight      int64     `db:"first_height"`
+		LastHeight       int64     `db:"last_height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT bucket, blocks_count, average_block_time, first_height, last_height 
+FROM block_time_series WHERE period = 'minute' ORDER BY bucket`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	// Heights 1, 5 and 6 have no consecutive previous block
+	suite.Require().True(rows[0].Bucket.Equal(start))
+	suite.Require().Equal(int64(8), rows[0].BlocksCount)
+	suite.Require().Equal(float64(5), rows[0].AverageBlockTime)
+	suite.Require().Equal(int64(2), rows[0].FirstHeight)
+	suite.Require().Equal(int64(11), rows[0].LastHeight)
+
+	suite.Require().True(rows[1].Bucket.Equal(start.Add(time.Minute)))
+	suite.Require().Equal(int64(9), rows[1].BlocksCount)
+	suite.Require().Equal(int64(12), rows[1].FirstHeight)
+	suite.Require().Equal(int64(20), rows[1].LastHeight)
+
+	bucket, err = suite.database.GetLastBlockTimeBucket(types.BlockTimePeriodMinute)
+	suite.Require().NoError(err)
+	suite.Require().True(bucket.Equal(start.Add(time.Minute)))
+}
@@ -1,17 +1,168 @@
 package database
 
-// GetMissingBlocks returns an array of missing blocks from one day ago
-func (db *Db) GetMissingBlocks(startHeight, endHeight int64) []int64 {
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// GetMissingBlocks returns the heights between the given start and end heights (both included)
+// that are not stored inside the block table
+func (db *Db) GetMissingBlocks(startHeight, endHeight int64) ([]int64, error) {
 	var result []int64
-	stmt := `SELECT generate_series($1::int,$2::int) EXCEPT SELECT height FROM block ORDER BY 1;`
-	err := db.Sqlx.Select(&result, stmt, startHeight, endHeight)
+	stmt := `
+SELECT series.height
+FROM generate_series($1::BIGINT, $2::BIGINT) AS series(height)
+LEFT JOIN block ON block.height = series.height
+WHERE block.height IS NULL
+ORDER BY series.height`
+	err := db.Reader(endHeight).Select(&result, stmt, startHeight, endHeight)
 	if err != nil {
-		return nil
+		return nil, fmt.Errorf("error while getting missing blocks: %s", err)
 	}
 
 	if len(result) == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// GetGapDetectorLastScannedHeight returns the latest height up to which the block table
+// has been scanned for missing heights, or 0 if it has never been scanned
+func (db *Db) GetGapDetectorLastScannedHeight() (int64, error) {
+	var heights []int64
+	err := db.Sqlx.Select(&heights, `SELECT last_scanned_height FROM gap_detector_state`)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting gap detector last scanned height: %s", err)
+	}
+
+	if len(heights) == 0 {
+		return 0, nil
+	}
+
+	return heights[0], nil
+}
+
+// SaveGapDetectorLastScannedHeight stores the given height as the latest one up to which
+// the block table has been scanned for missing heights
+func (db *Db) SaveGapDetectorLastScannedHeight(height int64) error {
+	stmt := `
+INSERT INTO gap_detector_state (last_scanned_height) 
+VALUES ($1) 
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET last_scanned_height = excluded.last_scanned_height
+WHERE gap_detector_state.last_scanned_height <= excluded.last_scanned_height`
+
+	_, err := db.SQL.Exec(stmt, height)
+	if err != nil {
+		return fmt.Errorf("error while storing gap detector last scanned height: %s", err)
+	}
+
+	return nil
+}
+
+// SaveMissingBlocks stores the given heights as missing, so that they are refetched starting from the given
+// first retry time. Heights that are already stored as missing are left untouched
+func (db *Db) SaveMissingBlocks(heights []int64, detectedAt time.Time, firstRetryAt time.Time) error {
+	if len(heights) == 0 {
 		return nil
 	}
 
-	return result
+	stmt := `
+INSERT INTO missing_block (height, next_retry_at, detected_at) 
+SELECT unnest($1::BIGINT[]), $2::TIMESTAMP, $3::TIMESTAMP 
+ON CONFLICT (height) DO NOTHING`
+
+	_, err := db.SQL.Exec(stmt, pq.Array(heights), firstRetryAt, detectedAt)
+	if err != nil {
+		return fmt.Errorf("error while storing missing blocks: %s", err)
+	}
+
+	return nil
+}
+
+// saveIncompleteBlock stores the given height as missing using the given transaction, because the block has been
+// stored without the writes of its modules for the given reason. Since the refetch of a missing block might be
+// the one failing, the detection time of an existing missing block is updated as well
+func saveIncompleteBlock(tx *sql.Tx, height int64, reason string, detectedAt time.Time) error {
+	stmt := `
+INSERT INTO missing_block (height, last_error, next_retry_at, detected_at) 
+VALUES ($1, $2, $3, $3) 
+ON CONFLICT (height) DO UPDATE 
+    SET last_error = excluded.last_error, 
+        detected_at = excluded.detected_at`
+
+	_, err := tx.Exec(stmt, height, reason, detectedAt)
+	if err != nil {
+		return fmt.Errorf("error while storing incomplete block: %s", err)
+	}
+
+	return nil
+}
+
+// GetDueMissingBlocks returns at most limit missing blocks that should be refetched at the given time,
+// starting from the lowest height
+func (db *Db) GetDueMissingBlocks(now time.Time, limit int) ([]dbtypes.MissingBlockRow, error) {
+	stmt := `
+SELECT height, retry_count, last_error, next_retry_at, detected_at 
+FROM missing_block 
+WHERE next_retry_at <= $1 
+ORDER BY height 
+LIMIT $2`
+
+	var rows []dbtypes.MissingBlockRow
+	err := db.Sqlx.Select(&rows, stmt, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting due missing blocks: %s", err)
+	}
+
+	return rows, nil
+}
+
+// CountMissingBlocks returns the number of missing blocks that have not been refetched yet
+func (db *Db) CountMissingBlocks() (int64, error) {
+	var count int64
+	err := db.SQL.QueryRow(`SELECT COUNT(*) FROM missing_block`).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error while counting missing blocks: %s", err)
+	}
+
+	return count, nil
+}
+
+// DeleteMissingBlock removes the given height from the missing blocks after it has been refetched, unless it has
+// been detected again after the given detection time. It returns whether the missing block has been removed
+func (db *Db) DeleteMissingBlock(height int64, detectedAt time.Time) (bool, error) {
+	res, err := db.SQL.Exec(`DELETE FROM missing_block WHERE height = $1 AND detected_at = $2`, height, detectedAt)
+	if err != nil {
+		return false, fmt.Errorf("error while deleting missing block: %s", err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error while getting deleted missing blocks: %s", err)
+	}
+
+	return rows > 0, nil
+}
+
+// SaveMissingBlockFailure stores the given error that has been returned while refetching the given height,
+// scheduling its next retry at the given time
+func (db *Db) SaveMissingBlockFailure(height int64, refetchErr error, nextRetryAt time.Time) error {
+	stmt := `
+UPDATE missing_block 
+SET retry_count = retry_count + 1, last_error = $2, next_retry_at = $3 
+WHERE height = $1`
+
+	_, err := db.SQL.Exec(stmt, height, refetchErr.Error(), nextRetryAt)
+	if err != nil {
+		return fmt.Errorf("error while storing missing block failure: %s", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,75 @@
+package database_test
+
+import (
+	"fmt"
+	"time"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_GetMissingBlocks() {
+	suite.getBlock(10)
+	suite.getBlock(12)
+
+	missing, err := suite.database.GetMissingBlocks(9, 13)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{9, 11, 13}, missing)
+
+	missing, err = suite.database.GetMissingBlocks(10, 10)
+	suite.Require().NoError(err)
+	suite.Require().Nil(missing)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GapDetectorLastScannedHeight() {
+	height, err := suite.database.GetGapDetectorLastScannedHeight()
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+
+	err = suite.database.SaveGapDetectorLastScannedHeight(100)
+	suite.Require().NoError(err)
+
+	// A lower height should not override the stored one
+	err = suite.database.SaveGapDetectorLastScannedHeight(50)
+	suite.Require().NoError(err)
+
+	height, err = suite.database.GetGapDetectorLastScannedHeight()
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_MissingBlocks() {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveMissingBlocks([]int64{11, 13, 15}, now, now)
+	suite.Require().NoError(err)
+
+	// Storing the same heights twice should not reset their state
+	err = suite.database.SaveMissingBlockFailure(13, fmt.Errorf("node unavailable"), now.Add(time.Hour))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMissingBlocks([]int64{13}, now, now)
+	suite.Require().NoError(err)
+
+	rows, err := suite.database.GetDueMissingBlocks(now, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(int64(11), rows[0].Height)
+	suite.Require().Equal(int64(15), rows[1].Height)
+
+	rows, err = suite.database.GetDueMissingBlocks(now.Add(time.Hour), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+	suite.Require().Equal(1, rows[1].RetryCount)
+	suite.Require().Equal("node unavailable", rows[1].LastError.String)
+
+	// Missing blocks detected again after being fetched should not be removed
+	deleted, err := suite.database.DeleteMissingBlock(11, now.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().False(deleted)
+
+	deleted, err = suite.database.DeleteMissingBlock(11, rows[0].DetectedAt)
+	suite.Require().NoError(err)
+	suite.Require().True(deleted)
+
+	count, err := suite.database.CountMissingBlocks()
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), count)
+}
@@ -5,6 +5,7 @@ import (
 
 	db "github.com/forbole/juno/v5/database"
 	"github.com/forbole/juno/v5/database/postgresql"
+	"github.com/forbole/juno/v5/types/config"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -15,6 +16,9 @@ var _ db.Database = &Db{}
 type Db struct {
 	*postgresql.Database
 	Sqlx *sqlx.DB
+
+	blockBatches *blockBatches
+	replicas     *readReplicas
 }
 
 // Builder allows to create a new Db instance implementing the db.Builder type
@@ -29,10 +33,31 @@ func Builder(ctx *db.Context) (db.Database, error) {
 		return nil, fmt.Errorf("invalid configuration database, must be PostgreSQL")
 	}
 
-	return &Db{
+	bdDatabase := &Db{
 		Database: psqlDb,
 		Sqlx:     sqlx.NewDb(psqlDb.SQL.DB, "postgresql"),
-	}, nil
+
+		blockBatches: newBlockBatches(),
+	}
+
+	bz, err := config.Cfg.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	replicasCfg, err := ParseReplicasConfig(bz)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing read replicas config: %s", err)
+	}
+
+	if len(replicasCfg.URLs) > 0 {
+		err = bdDatabase.SetReadReplicas(replicasCfg, ctx.Cfg.MaxOpenConnections, ctx.Cfg.MaxIdleConnections)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bdDatabase, nil
 }
 
 // Cast allows to cast the given db to a Db instance
@@ -1,14 +1,17 @@
 package database
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	dbtypes "github.com/forbole/callisto/v4/database/types"
 
 	"github.com/forbole/callisto/v4/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/lib/pq"
 )
 
@@ -29,6 +32,21 @@ WHERE community_pool.height <= excluded.height`
 	return nil
 }
 
+// GetCommunityPool returns the most recent community pool stored inside the database
+func (db *Db) GetCommunityPool() (sdk.DecCoins, error) {
+	var rows []dbtypes.CommunityPoolRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM community_pool`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting community pool: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return rows[0].Coins.ToDecCoins(), nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // SaveDistributionParams allows to store the given distribution parameters inside the database
@@ -45,9 +63,219 @@ ON CONFLICT (one_row_id) DO UPDATE
     SET params = excluded.params,
       	height = excluded.height
 WHERE distribution_params.height <= excluded.height`
-	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
+	return db.execBlockOperation(params.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, string(paramsBz), params.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing distribution params: %s", err)
+		}
+		return saveParamsHistory(tx, distrtypes.ModuleName, paramsBz, params.Height)
+	})
+}
+
+// GetDistributionParams returns the most recent distribution parameters, or nil if they have not been stored yet
+func (db *Db) GetDistributionParams() (*types.DistributionParams, error) {
+	var rows []dbtypes.DistributionParamsRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM distribution_params`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting distribution params: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	var params distrtypes.Params
+	err = json.Unmarshal([]byte(rows[0].Params), &params)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshaling distribution params: %s", err)
+	}
+
+	return types.NewDistributionParams(params, rows[0].Height), nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveDelegationRewardWithdrawals allows to store the given delegation reward withdrawals
+func (db *Db) SaveDelegationRewardWithdrawals(withdrawals []types.DelegationRewardWithdrawal) error {
+	if len(withdrawals) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO delegation_reward_withdrawal 
+    (tx_hash, msg_index, delegator_address, validator_address, amount, height, timestamp) 
+VALUES `
+
+	var params []interface{}
+	for i, withdrawal := range withdrawals {
+		ai := i * 7
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7)
+		params = append(params,
+			withdrawal.TxHash,
+			withdrawal.MsgIndex,
+			withdrawal.DelegatorAddress,
+			withdrawal.ValidatorAddress,
+			pq.Array(dbtypes.NewDbCoins(withdrawal.Amount)),
+			withdrawal.Height,
+			withdrawal.Timestamp,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT DO NOTHING"
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing delegation reward withdrawals: %s", err)
+	}
+
+	return nil
+}
+
+// GetDelegationRewardsWithdrawnSince returns, for each validator operator address, the total amount of the given
+// denom that delegators have withdrawn as rewards after the given time
+func (db *Db) GetDelegationRewardsWithdrawnSince(since time.Time, denom string) (map[string]sdk.Dec, error) {
+	stmt := `
+SELECT validator_address, sum((coin).amount::NUMERIC)::TEXT AS amount
+FROM delegation_reward_withdrawal, unnest(amount) AS coin
+WHERE timestamp > $1 AND (coin).denom = $2
+GROUP BY validator_address`
+
+	var rows []dbtypes.ValidatorRewardsWithdrawnRow
+	err := db.Sqlx.Select(&rows, stmt, since, denom)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting withdrawn delegation rewards: %s", err)
+	}
+
+	withdrawn := make(map[string]sdk.Dec, len(rows))
+	for _, row := range rows {
+		amount, err := sdk.NewDecFromStr(row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing withdrawn delegation rewards: %s", err)
+		}
+		withdrawn[row.ValidatorAddress] = amount
+	}
+
+	return withdrawn, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveStakingAPR allows to store the given network staking APR, both as the current value and inside its history
+func (db *Db) SaveStakingAPR(apr types.StakingAPR) error {
+	stmt := `
+INSERT INTO staking_apr (annual_provisions, community_tax, bonded_tokens, apr, apy, height) 
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET annual_provisions = excluded.annual_provisions,
+        community_tax = excluded.community_tax,
+        bonded_tokens = excluded.bonded_tokens,
+        apr = excluded.apr,
+        apy = excluded.apy,
+        height = excluded.height
+WHERE staking_apr.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt,
+		apr.AnnualProvisions.String(), apr.CommunityTax.String(), apr.BondedTokens.String(),
+		apr.APR.String(), apr.APY.String(), apr.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing staking apr: %s", err)
+	}
+
+	stmt = `
+INSERT INTO staking_apr_history (annual_provisions, community_tax, bonded_tokens, apr, apy, height, timestamp) 
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (height) DO UPDATE 
+    SET annual_provisions = excluded.annual_provisions,
+        community_tax = excluded.community_tax,
+        bonded_tokens = excluded.bonded_tokens,
+        apr = excluded.apr,
+        apy = excluded.apy,
+        timestamp = excluded.timestamp`
+
+	_, err = db.SQL.Exec(stmt,
+		apr.AnnualProvisions.String(), apr.CommunityTax.String(), apr.BondedTokens.String(),
+		apr.APR.String(), apr.APY.String(), apr.Height, apr.Timestamp,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing staking apr history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveValidatorsAPR allows to store the given validators APR, both as the current values and inside their history
+func (db *Db) SaveValidatorsAPR(aprs []types.ValidatorAPR) error {
+	if len(aprs) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_apr 
+    (validator_address, commission, apr, apy, realized_apr_7d, realized_apr_30d, realized_apr_90d, height) 
+VALUES `
+	historyStmt := `
+INSERT INTO validator_apr_history 
+    (validator_address, commission, apr, apy, realized_apr_7d, realized_apr_30d, realized_apr_90d, height, timestamp) 
+VALUES `
+
+	var params []interface{}
+	var historyParams []interface{}
+	for i, apr := range aprs {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d),",
+			ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		hi := i * 9
+		historyStmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d),",
+			hi+1, hi+2, hi+3, hi+4, hi+5, hi+6, hi+7, hi+8, hi+9)
+
+		values := []interface{}{
+			apr.ValidatorAddress,
+			apr.Commission.String(),
+			apr.APR.String(),
+			apr.APY.String(),
+			dbtypes.ToNullDec(apr.RealizedAPR7d),
+			dbtypes.ToNullDec(apr.RealizedAPR30d),
+			dbtypes.ToNullDec(apr.RealizedAPR90d),
+			apr.Height,
+		}
+		params = append(params, values...)
+		historyParams = append(historyParams, append(values, apr.Timestamp)...)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (validator_address) DO UPDATE 
+    SET commission = excluded.commission,
+        apr = excluded.apr,
+        apy = excluded.apy,
+        realized_apr_7d = excluded.realized_apr_7d,
+        realized_apr_30d = excluded.realized_apr_30d,
+        realized_apr_90d = excluded.realized_apr_90d,
+        height = excluded.height
+WHERE validator_apr.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators apr: %s", err)
+	}
+
+	historyStmt = historyStmt[:len(historyStmt)-1] // Remove trailing ","
+	historyStmt += `
+ON CONFLICT (validator_address, height) DO UPDATE 
+    SET commission = excluded.commission,
+        apr = excluded.apr,
+        apy = excluded.apy,
+        realized_apr_7d = excluded.realized_apr_7d,
+        realized_apr_30d = excluded.realized_apr_30d,
+        realized_apr_90d = excluded.realized_apr_90d,
+        timestamp = excluded.timestamp`
+
+	_, err = db.SQL.Exec(historyStmt, historyParams...)
 	if err != nil {
-		return fmt.Errorf("error while storing distribution params: %s", err)
+		return fmt.Errorf("error while storing validators apr history: %s", err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package database_test
 
 import (
 	"encoding/json"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -94,3 +95,92 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveDistributionParams() {
 	suite.Require().Equal(distrParams, stored)
 	suite.Require().Equal(int64(10), rows[0].Height)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_GetDistributionParams() {
+	params, err := suite.database.GetDistributionParams()
+	suite.Require().NoError(err)
+	suite.Require().Nil(params)
+
+	distrParams := distrtypes.Params{CommunityTax: sdk.NewDecWithPrec(2, 2)}
+	err = suite.database.SaveDistributionParams(types.NewDistributionParams(distrParams, 10))
+	suite.Require().NoError(err)
+
+	params, err = suite.database.GetDistributionParams()
+	suite.Require().NoError(err)
+	suite.Require().True(distrParams.CommunityTax.Equal(params.CommunityTax))
+	suite.Require().Equal(int64(10), params.Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DelegationRewardWithdrawals() {
+	now := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+	delegator := "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"
+	validator := "cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl"
+
+	withdrawals := []types.DelegationRewardWithdrawal{
+		types.NewDelegationRewardWithdrawal("HASH_1", 0, delegator, validator,
+			sdk.NewCoins(sdk.NewInt64Coin("udaric", 100), sdk.NewInt64Coin("utoken", 5)), 10, now.AddDate(0, 0, -1)),
+		types.NewDelegationRewardWithdrawal("HASH_2", 0, delegator, validator,
+			sdk.NewCoins(sdk.NewInt64Coin("udaric", 50)), 5, now.AddDate(0, 0, -8)),
+	}
+	err := suite.database.SaveDelegationRewardWithdrawals(withdrawals)
+	suite.Require().NoError(err)
+
+	// Storing the same withdrawals again should not duplicate them
+	err = suite.database.SaveDelegationRewardWithdrawals(withdrawals)
+	suite.Require().NoError(err)
+
+	withdrawn, err := suite.database.GetDelegationRewardsWithdrawnSince(now.AddDate(0, 0, -7), "udaric")
+	suite.Require().NoError(err)
+	suite.Require().Len(withdrawn, 1)
+	suite.Require().True(sdk.NewDec(100).Equal(withdrawn[validator]))
+
+	withdrawn, err = suite.database.GetDelegationRewardsWithdrawnSince(now.AddDate(0, 0, -30), "udaric")
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.NewDec(150).Equal(withdrawn[validator]))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAPR() {
+	timestamp := time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveStakingAPR(types.NewStakingAPR(
+		sdk.NewDec(1000), sdk.NewDecWithPrec(2, 2), sdk.NewInt(5000),
+		sdk.NewDecWithPrec(196, 3), sdk.NewDecWithPrec(216, 3), 10, timestamp,
+	))
+	suite.Require().NoError(err)
+
+	var apr []string
+	err = suite.database.Sqlx.Select(&apr, `SELECT apr::TEXT FROM staking_apr`)
+	suite.Require().NoError(err)
+	suite.Require().Len(apr, 1)
+	suite.Require().Equal(sdk.NewDecWithPrec(196, 3).String(), apr[0])
+
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	realized := sdk.NewDecWithPrec(15, 2)
+	for _, height := range []int64{10, 20} {
+		err = suite.database.SaveValidatorsAPR([]types.ValidatorAPR{
+			types.NewValidatorAPR(
+				validator.GetConsAddr(), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(186, 3), sdk.NewDecWithPrec(204, 3),
+				&realized, nil, nil, height, timestamp,
+			),
+		})
+		suite.Require().NoError(err)
+	}
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT count(*) FROM validator_apr`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	err = suite.database.SQL.QueryRow(`SELECT count(*) FROM validator_apr_history`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+
+	err = suite.database.SQL.QueryRow(`SELECT count(*) FROM validator_apr WHERE realized_apr_30d IS NULL`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+}
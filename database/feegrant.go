@@ -1,10 +1,17 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/lib/pq"
 
+	dbtypes "github.com/forbole/callisto/v4/database/types"
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -18,20 +25,44 @@ func (db *Db) SaveFeeGrantAllowance(allowance types.FeeGrant) error {
 		return fmt.Errorf("error while storing fee grant allowance accounts: %s", err)
 	}
 
-	stmt := `
-INSERT INTO fee_grant_allowance(grantee_address, granter_address, allowance, height) 
-VALUES ($1, $2, $3, $4) 
-ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance DO UPDATE 
-    SET allowance = excluded.allowance,
-        height = excluded.height
-WHERE fee_grant_allowance.height <= excluded.height`
+	feeAllowance, err := allowance.GetGrant()
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant allowance: %s", err)
+	}
+
+	limits, err := types.NewFeeGrantLimits(feeAllowance)
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant allowance limits: %s", err)
+	}
 
 	allowanceJSON, err := codec.ProtoMarshalJSON(allowance.Allowance, nil)
 	if err != nil {
 		return fmt.Errorf("error while marshaling grant allowance: %s", err)
 	}
 
-	_, err = db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowanceJSON, allowance.Height)
+	stmt := `
+INSERT INTO fee_grant_allowance(
+	grantee_address, granter_address, allowance, remaining_allowance, spend_limit, period_spend_limit,
+	period_can_spend, period_reset, expiration, allowed_messages, status, height
+)
+VALUES ($1, $2, $3, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance DO UPDATE
+    SET allowance = excluded.allowance,
+        remaining_allowance = excluded.remaining_allowance,
+        spend_limit = excluded.spend_limit,
+        period_spend_limit = excluded.period_spend_limit,
+        period_can_spend = excluded.period_can_spend,
+        period_reset = excluded.period_reset,
+        expiration = excluded.expiration,
+        allowed_messages = excluded.allowed_messages
+WHERE fee_grant_allowance.last_used_height IS NULL`
+
+	_, err = db.SQL.Exec(stmt,
+		allowance.Grantee, allowance.Granter, string(allowanceJSON),
+		toNullableDbCoins(limits.SpendLimit), toNullableDbCoins(limits.PeriodSpendLimit),
+		toNullableDbCoins(limits.PeriodCanSpend), limits.PeriodReset, limits.Expiration,
+		toNullableStrings(limits.AllowedMessages), types.FeeGrantStatusActive, allowance.Height,
+	)
 	if err != nil {
 		return fmt.Errorf("error while saving fee grant allowance: %s", err)
 	}
@@ -39,13 +70,216 @@ WHERE fee_grant_allowance.height <= excluded.height`
 	return nil
 }
 
-// DeleteFeeGrantAllowance removes the fee grant allowance data from the database
-func (db *Db) DeleteFeeGrantAllowance(allowance types.GrantRemoval) error {
-	stmt := `DELETE FROM fee_grant_allowance WHERE grantee_address = $1 AND granter_address = $2 AND height <= $3`
-	_, err := db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowance.Height)
+// toNullableDbCoins returns the given coins as a COIN[] value, or nil if the given coins are nil
+func toNullableDbCoins(coins sdk.Coins) interface{} {
+	if coins == nil {
+		return nil
+	}
+	return pq.Array(dbtypes.NewDbCoins(coins))
+}
+
+// toNullableStrings returns the given strings as a TEXT[] value, or nil if the given strings are nil
+func toNullableStrings(values []string) interface{} {
+	if values == nil {
+		return nil
+	}
+	return pq.Array(values)
+}
+
+// RevokeFeeGrantAllowance marks the active fee grant allowance having the data of the given removal
+// with the given status, keeping it as history
+func (db *Db) RevokeFeeGrantAllowance(allowance types.GrantRemoval, status string) error {
+	stmt := `
+UPDATE fee_grant_allowance
+SET status = $4, end_height = $3
+WHERE grantee_address = $1 AND granter_address = $2 AND height <= $3 AND status = $5`
+	return db.execBlockOperation(allowance.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, allowance.Grantee, allowance.Granter, allowance.Height, status,
+			types.FeeGrantStatusActive)
+		if err != nil {
+			return fmt.Errorf("error while revoking grant allowance: %s", err)
+		}
+		return nil
+	})
+}
+
+// ExpireFeeGrantAllowances marks as expired all the active fee grant allowances
+// whose expiration time is before the given block timestamp
+func (db *Db) ExpireFeeGrantAllowances(height int64, timestamp time.Time) error {
+	stmt := `
+UPDATE fee_grant_allowance
+SET status = $1, end_height = $2
+WHERE status = $3 AND expiration < $4 AND height <= $2`
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, types.FeeGrantStatusExpired, height, types.FeeGrantStatusActive, timestamp)
+		if err != nil {
+			return fmt.Errorf("error while expiring grant allowances: %s", err)
+		}
+		return nil
+	})
+}
+
+// GetFeeGrantAllowancesToResetPeriod returns the active fee grant allowances granted before the given height
+// whose current period has ended before the given block timestamp
+func (db *Db) GetFeeGrantAllowancesToResetPeriod(height int64, timestamp time.Time) ([]dbtypes.FeeAllowanceRow, error) {
+	stmt := `
+SELECT id, grantee_address, granter_address, allowance, remaining_allowance, spent, status, height, end_height
+FROM fee_grant_allowance
+WHERE status = $1 AND period_reset <= $2 AND height <= $3
+ORDER BY id`
+
+	var rows []dbtypes.FeeAllowanceRow
+	err := db.Sqlx.Select(&rows, stmt, types.FeeGrantStatusActive, timestamp, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting fee grant allowances to reset: %s", err)
+	}
+
+	return rows, nil
+}
+
+// ResetFeeGrantAllowancePeriod stores the given amount that can be spent during the current period
+// and the given end of the current period of the fee grant allowance having the given id.
+// The remaining allowance is left unchanged, since the period is only reset on chain once the allowance is used.
+// Allowances that are no longer active are ignored
+func (db *Db) ResetFeeGrantAllowancePeriod(
+	id uint64, periodCanSpend sdk.Coins, periodReset time.Time, height int64,
+) error {
+	stmt := `
+UPDATE fee_grant_allowance
+SET period_can_spend = $2, period_reset = $3
+WHERE id = $1 AND period_reset < $3 AND status = $4`
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, id, toNullableDbCoins(periodCanSpend), periodReset, types.FeeGrantStatusActive)
+		if err != nil {
+			return fmt.Errorf("error while resetting fee grant allowance period: %s", err)
+		}
+		return nil
+	})
+}
+
+// GetActiveFeeGrantAllowance returns the latest active fee grant allowance between the given grantee and granter
+// that has been granted before the given height, or nil if no allowance is found
+func (db *Db) GetActiveFeeGrantAllowance(grantee, granter string, height int64) (*dbtypes.FeeAllowanceRow, error) {
+	stmt := `
+SELECT id, grantee_address, granter_address, allowance, remaining_allowance, spent, status, height, end_height
+FROM fee_grant_allowance
+WHERE grantee_address = $1 AND granter_address = $2 AND height <= $3 AND status = $4
+ORDER BY height DESC
+LIMIT 1`
+
+	var rows []dbtypes.FeeAllowanceRow
+	err := db.Sqlx.Select(&rows, stmt, grantee, granter, height, types.FeeGrantStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting fee grant allowance: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// SaveFeeGrantUsage stores the given fee grant usage, along with the given state of the allowance that has
+// been used to pay the fee. If update is nil, only the usage is stored.
+// The allowance is updated only the first time the usage is stored, so that handling
+// the same transaction twice does not use the allowance twice
+func (db *Db) SaveFeeGrantUsage(usage types.FeeGrantUsage, update *types.FeeGrantAllowanceUpdate) error {
+	// Store the accounts
+	var accounts []types.Account
+	accounts = append(accounts, types.NewAccount(usage.Granter), types.NewAccount(usage.Grantee))
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing fee grant usage accounts: %s", err)
+	}
 
+	tx, err := db.SQL.Begin()
 	if err != nil {
-		return fmt.Errorf("error while deleting grant allowance: %s", err)
+		return fmt.Errorf("error while beginning fee grant usage transaction: %s", err)
 	}
+	defer tx.Rollback()
+
+	var allowanceID sql.NullInt64
+	if update != nil {
+		allowanceID = sql.NullInt64{Int64: int64(update.ID), Valid: true}
+	}
+
+	stmt := `
+INSERT INTO fee_grant_usage (transaction_hash, allowance_id, grantee_address, granter_address, fee, height, timestamp)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (transaction_hash) DO NOTHING`
+	res, err := tx.Exec(stmt,
+		usage.TxHash, allowanceID, usage.Grantee, usage.Granter, pq.Array(dbtypes.NewDbCoins(usage.Fee)),
+		usage.Height, usage.Timestamp,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing fee grant usage: %s", err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant usage affected rows: %s", err)
+	}
+
+	// Skip updating the allowance if the usage has already been handled
+	if inserted == 0 || update == nil {
+		return tx.Commit()
+	}
+
+	err = saveFeeGrantAllowanceUpdate(tx, *update)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// saveFeeGrantAllowanceUpdate stores the given allowance update using the given transaction
+func saveFeeGrantAllowanceUpdate(tx *sql.Tx, update types.FeeGrantAllowanceUpdate) error {
+	limits, err := types.NewFeeGrantLimits(update.Allowance)
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant allowance limits: %s", err)
+	}
+
+	protoAllowance, ok := update.Allowance.(proto.Message)
+	if !ok {
+		return fmt.Errorf("invalid fee grant allowance type: %T", update.Allowance)
+	}
+
+	anyAllowance, err := codectypes.NewAnyWithValue(protoAllowance)
+	if err != nil {
+		return fmt.Errorf("error while packing grant allowance: %s", err)
+	}
+
+	allowanceJSON, err := codec.ProtoMarshalJSON(anyAllowance, nil)
+	if err != nil {
+		return fmt.Errorf("error while marshaling grant allowance: %s", err)
+	}
+
+	var endHeight sql.NullInt64
+	if update.Status != types.FeeGrantStatusActive {
+		endHeight = sql.NullInt64{Int64: update.Height, Valid: true}
+	}
+
+	stmt := `
+UPDATE fee_grant_allowance
+SET remaining_allowance = $2,
+    spend_limit = $3,
+    period_can_spend = $4,
+    period_reset = $5,
+    spent = $6,
+    last_used_height = $7,
+    status = $8,
+    end_height = $9
+WHERE id = $1`
+	_, err = tx.Exec(stmt,
+		update.ID, string(allowanceJSON), toNullableDbCoins(limits.SpendLimit),
+		toNullableDbCoins(limits.PeriodCanSpend), limits.PeriodReset, pq.Array(dbtypes.NewDbCoins(update.Spent)),
+		update.Height, update.Status, endHeight,
+	)
+	if err != nil {
+		return fmt.Errorf("error while updating fee grant allowance: %s", err)
+	}
+
 	return nil
 }
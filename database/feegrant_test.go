@@ -1,6 +1,8 @@
 package database_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 
@@ -29,16 +31,19 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveFeeGrantAllowance() {
 
 	// Verify the data
 	var rows []dbtypes.FeeAllowanceRow
-	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_allowance`)
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT id, grantee_address, granter_address, allowance, remaining_allowance, spent, status, height, end_height 
+FROM fee_grant_allowance`)
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, 1)
 	suite.Require().Equal(rows[0].Granter, granter.String())
 	suite.Require().Equal(rows[0].Grantee, grantee.String())
 	suite.Require().Equal(rows[0].Height, int64(121622))
-
+	suite.Require().Equal(types.FeeGrantStatusActive, rows[0].Status)
+	suite.Require().Equal(rows[0].Allowance, rows[0].RemainingAllowance)
 }
 
-func (suite *DbTestSuite) TestBigDipperDb_RemoveFeeGrantAllowance() {
+func (suite *DbTestSuite) TestBigDipperDb_RevokeFeeGrantAllowance() {
 	allowance := &feegranttypes.BasicAllowance{SpendLimit: nil, Expiration: nil}
 	granter, err := sdk.AccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
 	suite.Require().NoError(err)
@@ -52,17 +57,173 @@ func (suite *DbTestSuite) TestBigDipperDb_RemoveFeeGrantAllowance() {
 	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, 121622))
 	suite.Require().NoError(err)
 
-	// Delete the data
-	err = suite.database.DeleteFeeGrantAllowance(types.NewGrantRemoval(
+	// Revoke the allowance
+	err = suite.database.RevokeFeeGrantAllowance(types.NewGrantRemoval(
 		"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
 		"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
 		122222,
-	))
+	), types.FeeGrantStatusRevoked)
+	suite.Require().NoError(err)
+
+	// Grant it again
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, 122223))
+	suite.Require().NoError(err)
+
+	// Verify the data
+	var rows []dbtypes.FeeAllowanceRow
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT id, grantee_address, granter_address, allowance, remaining_allowance, spent, status, height, end_height 
+FROM fee_grant_allowance ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(types.FeeGrantStatusRevoked, rows[0].Status)
+	suite.Require().Equal(int64(122222), rows[0].EndHeight.Int64)
+	suite.Require().Equal(types.FeeGrantStatusActive, rows[1].Status)
+	suite.Require().False(rows[1].EndHeight.Valid)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ExpireFeeGrantAllowances() {
+	expiration := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	allowance := &feegranttypes.BasicAllowance{SpendLimit: nil, Expiration: &expiration}
+	granter, err := sdk.AccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
+	suite.Require().NoError(err)
+
+	grantee, err := sdk.AccAddressFromBech32("cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn")
+	suite.Require().NoError(err)
+
+	feeGrant, err := feegranttypes.NewGrant(granter, grantee, allowance)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, 100))
+	suite.Require().NoError(err)
+
+	// Not expired yet
+	err = suite.database.ExpireFeeGrantAllowances(110, expiration.Add(-time.Minute))
+	suite.Require().NoError(err)
+
+	row, err := suite.database.GetActiveFeeGrantAllowance(grantee.String(), granter.String(), 110)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(row)
+
+	// Expired
+	err = suite.database.ExpireFeeGrantAllowances(120, expiration.Add(time.Minute))
+	suite.Require().NoError(err)
+
+	row, err = suite.database.GetActiveFeeGrantAllowance(grantee.String(), granter.String(), 120)
+	suite.Require().NoError(err)
+	suite.Require().Nil(row)
+
+	var status string
+	err = suite.database.SQL.QueryRow(`SELECT status FROM fee_grant_allowance`).Scan(&status)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FeeGrantStatusExpired, status)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveFeeGrantUsage() {
+	allowance := &feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))}
+	granter, err := sdk.AccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
+	suite.Require().NoError(err)
+
+	grantee, err := sdk.AccAddressFromBech32("cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn")
+	suite.Require().NoError(err)
+
+	feeGrant, err := feegranttypes.NewGrant(granter, grantee, allowance)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, 100))
+	suite.Require().NoError(err)
+
+	row, err := suite.database.GetActiveFeeGrantAllowance(grantee.String(), granter.String(), 110)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(row)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 40))
+	usage := types.NewFeeGrantUsage(
+		"TX_HASH", grantee.String(), granter.String(), fee, 110, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	update := types.NewFeeGrantAllowanceUpdate(
+		row.ID,
+		&feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 60))},
+		fee,
+		types.FeeGrantStatusActive,
+		110,
+	)
+
+	err = suite.database.SaveFeeGrantUsage(usage, &update)
+	suite.Require().NoError(err)
+
+	// Handling the same usage twice should not change the data
+	update.Spent = fee.Add(fee...)
+	err = suite.database.SaveFeeGrantUsage(usage, &update)
+	suite.Require().NoError(err)
+
+	var usages []dbtypes.FeeGrantUsageRow
+	err = suite.database.Sqlx.Select(&usages, `
+SELECT transaction_hash, allowance_id, grantee_address, granter_address, fee, height FROM fee_grant_usage`)
+	suite.Require().NoError(err)
+	suite.Require().Len(usages, 1)
+	suite.Require().Equal(int64(row.ID), usages[0].AllowanceID.Int64)
+	suite.Require().Equal(fee, usages[0].Fee.ToCoins())
+
+	var spendLimit dbtypes.DbCoins
+	err = suite.database.SQL.QueryRow(`SELECT spend_limit FROM fee_grant_allowance`).Scan(&spendLimit)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 60)), spendLimit.ToCoins())
+
+	row, err = suite.database.GetActiveFeeGrantAllowance(grantee.String(), granter.String(), 110)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, row.Spent.ToCoins())
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ResetFeeGrantAllowancePeriod() {
+	periodReset := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	allowance := &feegranttypes.PeriodicAllowance{
+		Basic:            feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))},
+		Period:           24 * time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)),
+		PeriodReset:      periodReset,
+	}
+	granter, err := sdk.AccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
+	suite.Require().NoError(err)
+
+	grantee, err := sdk.AccAddressFromBech32("cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn")
+	suite.Require().NoError(err)
+
+	feeGrant, err := feegranttypes.NewGrant(granter, grantee, allowance)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, 100))
+	suite.Require().NoError(err)
+
+	// The period has not ended yet
+	rows, err := suite.database.GetFeeGrantAllowancesToResetPeriod(110, periodReset.Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+
+	// The period has ended
+	rows, err = suite.database.GetFeeGrantAllowancesToResetPeriod(110, periodReset.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	nextReset := periodReset.Add(24 * time.Hour)
+	err = suite.database.ResetFeeGrantAllowancePeriod(rows[0].ID, allowance.PeriodSpendLimit, nextReset, 110)
+	suite.Require().NoError(err)
+
+	// Resetting to a previous period should not change the data
+	err = suite.database.ResetFeeGrantAllowancePeriod(rows[0].ID, allowance.PeriodCanSpend, periodReset, 110)
+	suite.Require().NoError(err)
+
+	var canSpend dbtypes.DbCoins
+	var reset time.Time
+	err = suite.database.SQL.QueryRow(`SELECT period_can_spend, period_reset FROM fee_grant_allowance`).
+		Scan(&canSpend, &reset)
 	suite.Require().NoError(err)
+	suite.Require().Equal(allowance.PeriodSpendLimit, canSpend.ToCoins())
+	suite.Require().True(nextReset.Equal(reset))
 
-	// verify the data
-	var count int
-	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM fee_grant_allowance`).Scan(&count)
+	// The remaining allowance keeps the on chain state
+	row, err := suite.database.GetActiveFeeGrantAllowance(grantee.String(), granter.String(), 110)
 	suite.Require().NoError(err)
-	suite.Require().Equal(0, count)
+	suite.Require().Equal(row.Allowance, row.RemainingAllowance)
 }
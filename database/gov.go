@@ -1,6 +1,7 @@
 package database
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/lib/pq"
@@ -30,12 +33,13 @@ ON CONFLICT (one_row_id) DO UPDATE
 	SET params = excluded.params,
 		height = excluded.height
 WHERE gov_params.height <= excluded.height`
-	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
-	if err != nil {
-		return fmt.Errorf("error while storing gov params: %s", err)
-	}
-
-	return nil
+	return db.execBlockOperation(params.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, string(paramsBz), params.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing gov params: %s", err)
+		}
+		return saveParamsHistory(tx, govtypes.ModuleName, paramsBz, params.Height)
+	})
 }
 
 // GetGovParams returns the most recent governance parameters
@@ -74,7 +78,7 @@ func (db *Db) SaveProposals(proposals []types.Proposal) error {
 	proposalsQuery := `
 INSERT INTO proposal(
 	id, title, description, metadata, content, proposer_address, status,
-    submit_time, deposit_end_time, voting_start_time, voting_end_time
+    submit_time, deposit_end_time, voting_start_time, voting_end_time, search_vector
 ) VALUES`
 	var proposalsParams []interface{}
 
@@ -84,8 +88,11 @@ INSERT INTO proposal(
 
 		// Prepare the proposal query
 		vi := i * 11
-		proposalsQuery += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
-			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8, vi+9, vi+10, vi+11)
+		proposalsQuery += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,%s),",
+			vi+1, vi+2, vi+3, vi+4, vi+5, vi+6, vi+7, vi+8, vi+9, vi+10, vi+11,
+			// The search vector is built from the title and the description, with the title weighting more
+			fmt.Sprintf("setweight(to_tsvector('simple', $%d::TEXT), 'A') || setweight(to_tsvector('simple', $%d::TEXT), 'B')",
+				vi+2, vi+3))
 
 		var jsonMessages []string
 		var protoCodec codec.ProtoCodec
@@ -130,7 +137,8 @@ ON CONFLICT (id) DO UPDATE
 		submit_time = excluded.submit_time,
 		deposit_end_time = excluded.deposit_end_time,
 		voting_start_time = excluded.voting_start_time,
-		voting_end_time = excluded.voting_end_time`
+		voting_end_time = excluded.voting_end_time,
+		search_vector = excluded.search_vector`
 	_, err = db.SQL.Exec(proposalsQuery, proposalsParams...)
 	if err != nil {
 		return fmt.Errorf("error while storing proposals: %s", err)
@@ -203,20 +211,21 @@ func (db *Db) GetOpenProposalsIds(blockTime time.Time) ([]uint64, error) {
 
 // --------------------------------------------------------------------------------------------------------------------
 
-// UpdateProposal updates a proposal stored inside the database
-func (db *Db) UpdateProposal(update types.ProposalUpdate) error {
+// UpdateProposal updates a proposal stored inside the database while handling the block at the given height
+func (db *Db) UpdateProposal(update types.ProposalUpdate, height int64) error {
 	query := `UPDATE proposal SET status = $1, voting_start_time = $2, voting_end_time = $3 where id = $4`
-	_, err := db.SQL.Exec(query,
-		update.Status,
-		update.VotingStartTime,
-		update.VotingEndTime,
-		update.ProposalID,
-	)
-	if err != nil {
-		return fmt.Errorf("error while updating proposal %d: %s", update.ProposalID, err)
-	}
-
-	return nil
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(query,
+			update.Status,
+			update.VotingStartTime,
+			update.VotingEndTime,
+			update.ProposalID,
+		)
+		if err != nil {
+			return fmt.Errorf("error while updating proposal %d: %s", update.ProposalID, err)
+		}
+		return nil
+	})
 }
 
 // SaveDeposits allows to save multiple deposits
@@ -330,6 +339,44 @@ WHERE proposal_tally_result.height <= excluded.height`
 	return nil
 }
 
+// SaveProposalTallyProjection allows to save the given projection of the outcome of a proposal
+func (db *Db) SaveProposalTallyProjection(projection types.ProposalTallyProjection) error {
+	stmt := `
+INSERT INTO proposal_tally_projection (
+	proposal_id, turnout, quorum, quorum_reached, yes_ratio, threshold, 
+	veto_ratio, veto_threshold, projected_status, height
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (proposal_id) DO UPDATE 
+	SET turnout = excluded.turnout,
+		quorum = excluded.quorum,
+		quorum_reached = excluded.quorum_reached,
+		yes_ratio = excluded.yes_ratio,
+		threshold = excluded.threshold,
+		veto_ratio = excluded.veto_ratio,
+		veto_threshold = excluded.veto_threshold,
+		projected_status = excluded.projected_status,
+		height = excluded.height
+WHERE proposal_tally_projection.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt,
+		projection.ProposalID,
+		projection.Turnout.String(),
+		projection.Quorum.String(),
+		projection.QuorumReached,
+		projection.YesRatio.String(),
+		projection.Threshold.String(),
+		projection.VetoRatio.String(),
+		projection.VetoThreshold.String(),
+		projection.ProjectedStatus,
+		projection.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing tally projection for proposal %d: %s", projection.ProposalID, err)
+	}
+
+	return nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveProposalStakingPoolSnapshot allows to save the given snapshot of the staking pool
@@ -355,6 +402,28 @@ WHERE proposal_staking_pool_snapshot.height <= excluded.height`
 	return nil
 }
 
+// GetProposalStakingPoolSnapshot returns the staking pool snapshot associated with the proposal having the given id,
+// or nil if no snapshot has been stored yet
+func (db *Db) GetProposalStakingPoolSnapshot(proposalID uint64) (*types.PoolSnapshot, error) {
+	var rows []dbtypes.ProposalStakingPoolSnapshotRow
+	stmt := `SELECT * FROM proposal_staking_pool_snapshot WHERE proposal_id = $1`
+	err := db.Sqlx.Select(&rows, stmt, proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting proposal %d staking pool snapshot: %s", proposalID, err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	row := rows[0]
+	return types.NewPoolSnapshot(
+		sdk.NewInt(row.BondedTokens),
+		sdk.NewInt(row.NotBondedTokens),
+		row.Height,
+	), nil
+}
+
 // SaveProposalValidatorsStatusesSnapshots allows to save the given validator statuses snapshots
 func (db *Db) SaveProposalValidatorsStatusesSnapshots(snapshots []types.ProposalValidatorStatusSnapshot) error {
 	if len(snapshots) == 0 {
@@ -404,13 +473,14 @@ ON CONFLICT (proposal_id) DO UPDATE SET
 	height = excluded.height
 WHERE software_upgrade_plan.height <= excluded.height`
 
-	_, err := db.SQL.Exec(stmt,
-		proposalID, plan.Name, plan.Height, plan.Info, height)
-	if err != nil {
-		return fmt.Errorf("error while storing software upgrade plan for proposal %d: %s", proposalID, err)
-	}
-
-	return nil
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt,
+			proposalID, plan.Name, plan.Height, plan.Info, height)
+		if err != nil {
+			return fmt.Errorf("error while storing software upgrade plan for proposal %d: %s", proposalID, err)
+		}
+		return nil
+	})
 }
 
 // DeleteSoftwareUpgradePlan allows to delete a SoftwareUpgradePlan with proposal ID
@@ -425,6 +495,20 @@ func (db *Db) DeleteSoftwareUpgradePlan(proposalID uint64) error {
 	return nil
 }
 
+// DeleteScheduledSoftwareUpgradePlans deletes all the software upgrade plans that have been stored
+// at or before the given height
+func (db *Db) DeleteScheduledSoftwareUpgradePlans(height int64) error {
+	stmt := `DELETE FROM software_upgrade_plan WHERE height <= $1`
+
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, height)
+		if err != nil {
+			return fmt.Errorf("error while deleting scheduled software upgrade plans: %s", err)
+		}
+		return nil
+	})
+}
+
 // CheckSoftwareUpgradePlan returns true if an upgrade is scheduled at the given height
 func (db *Db) CheckSoftwareUpgradePlan(upgradeHeight int64) (bool, error) {
 	var exist bool
@@ -438,14 +522,28 @@ func (db *Db) CheckSoftwareUpgradePlan(upgradeHeight int64) (bool, error) {
 	return exist, nil
 }
 
+// GetPassedSoftwareUpgradePlanHeights returns the distinct upgrade heights of the stored software upgrade plans
+// that are lower or equal to the given height, sorted in ascending order
+func (db *Db) GetPassedSoftwareUpgradePlanHeights(height int64) ([]int64, error) {
+	var heights []int64
+	stmt := `SELECT DISTINCT upgrade_height FROM software_upgrade_plan WHERE upgrade_height <= $1 ORDER BY upgrade_height`
+	err := db.Sqlx.Select(&heights, stmt, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting passed software upgrade plan heights: %s", err)
+	}
+
+	return heights, nil
+}
+
 // TruncateSoftwareUpgradePlan delete software upgrade plans once the upgrade height passed
 func (db *Db) TruncateSoftwareUpgradePlan(height int64) error {
 	stmt := `DELETE FROM software_upgrade_plan WHERE upgrade_height <= $1`
 
-	_, err := db.SQL.Exec(stmt, height)
-	if err != nil {
-		return fmt.Errorf("error while deleting software upgrade plan: %s", err)
-	}
-
-	return nil
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, height)
+		if err != nil {
+			return fmt.Errorf("error while deleting software upgrade plan: %s", err)
+		}
+		return nil
+	})
 }
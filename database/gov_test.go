@@ -352,7 +352,7 @@ func (suite *DbTestSuite) TestBigDipperDb_UpdateProposal() {
 		timestamp2,
 	)
 
-	err = suite.database.UpdateProposal(update)
+	err = suite.database.UpdateProposal(update, 10)
 	suite.Require().NoError(err)
 
 	expected := dbtypes.NewProposalRow(
@@ -595,6 +595,69 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTallyResults() {
 
 // -------------------------------------------------------------------------------------------------------------------
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalTallyProjection() {
+	_ = suite.getProposalRow(1)
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Save the projection
+
+	projection := types.NewProposalTallyProjection(
+		1,
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(334, 3),
+		true,
+		sdk.NewDecWithPrec(75, 2),
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(1, 1),
+		sdk.NewDecWithPrec(334, 3),
+		govtypesv1.StatusPassed.String(),
+		10,
+	)
+	err := suite.database.SaveProposalTallyProjection(projection)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalTallyProjectionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_projection`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.NewProposalTallyProjectionRow(
+		1, 0.5, 0.334, true, 0.75, 0.5, 0.1, 0.334, govtypesv1.StatusPassed.String(), 10,
+	)))
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with lower height
+
+	projection.ProjectedStatus = govtypesv1.StatusRejected.String()
+	projection.Height = 9
+	err = suite.database.SaveProposalTallyProjection(projection)
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ProposalTallyProjectionRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_projection`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.NewProposalTallyProjectionRow(
+		1, 0.5, 0.334, true, 0.75, 0.5, 0.1, 0.334, govtypesv1.StatusPassed.String(), 10,
+	)))
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with higher height
+
+	projection.Height = 11
+	err = suite.database.SaveProposalTallyProjection(projection)
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ProposalTallyProjectionRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_projection`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.NewProposalTallyProjectionRow(
+		1, 0.5, 0.334, true, 0.75, 0.5, 0.1, 0.334, govtypesv1.StatusRejected.String(), 11,
+	)))
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 func (suite *DbTestSuite) TestBigDipperDb_SaveProposalStakingPoolSnapshot() {
 	_ = suite.getBlock(9)
 	_ = suite.getBlock(10)
@@ -1029,3 +1092,30 @@ func (suite *DbTestSuite) TestBigDipperDb_CheckSoftwareUpgradePlan() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(false, exist)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_GetPassedSoftwareUpgradePlanHeights() {
+	_ = suite.getProposalRow(1)
+	_ = suite.getProposalRow(2)
+
+	// Save software upgrade plans with upgrade heights at 100 and 200
+	err := suite.database.SaveSoftwareUpgradePlan(1, upgradetypes.Plan{Name: "v2", Height: 200, Info: "info"}, 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveSoftwareUpgradePlan(2, upgradetypes.Plan{Name: "v1", Height: 100, Info: "info"}, 10)
+	suite.Require().NoError(err)
+
+	// Get the plans passed at a height before any upgrade
+	heights, err := suite.database.GetPassedSoftwareUpgradePlanHeights(99)
+	suite.Require().NoError(err)
+	suite.Require().Empty(heights)
+
+	// Get the plans passed at the first upgrade height
+	heights, err = suite.database.GetPassedSoftwareUpgradePlanHeights(100)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{100}, heights)
+
+	// Get the plans passed after both upgrades
+	heights, err = suite.database.GetPassedSoftwareUpgradePlanHeights(500)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{100, 200}, heights)
+}
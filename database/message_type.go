@@ -1,16 +1,96 @@
 package database
 
 import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
 	types "github.com/forbole/callisto/v4/types"
 )
 
 // SaveMessageType stores the given message type inside the database
 func (db *Db) SaveMessageType(msg *types.MessageType) error {
 	stmt := `
-INSERT INTO message_type(type, module, label, height) 
-VALUES ($1, $2, $3, $4) 
-ON CONFLICT (type) DO NOTHING`
+INSERT INTO message_type(type, module, label, height, last_seen_height) 
+VALUES ($1, $2, $3, $4, $4) 
+ON CONFLICT (type) DO UPDATE 
+    SET height = LEAST(message_type.height, excluded.height),
+        last_seen_height = GREATEST(message_type.last_seen_height, excluded.last_seen_height)`
+
+	return db.execBlockOperation(msg.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, msg.Type, msg.Module, msg.Label, msg.Height)
+		return err
+	})
+}
+
+// SaveMessageTypeBlockStats adds the given message types usage of the block having the given height and timestamp
+// to the message types daily statistics. Blocks that have already been included are ignored,
+// so that the same height can be handled more than once
+func (db *Db) SaveMessageTypeBlockStats(height int64, timestamp time.Time, stats []types.MessageTypeBlockStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		res, err := tx.Exec(`INSERT INTO message_type_stats_block (height) VALUES ($1) ON CONFLICT DO NOTHING`, height)
+		if err != nil {
+			return fmt.Errorf("error while storing message type stats block: %s", err)
+		}
+
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("error while getting message type stats block rows: %s", err)
+		}
+
+		// Skip if the block has already been included
+		if inserted == 0 {
+			return nil
+		}
+
+		date := timestamp.UTC().Truncate(24 * time.Hour)
+		for _, stat := range stats {
+			stmt := `
+INSERT INTO message_type_daily_stats (type, date, messages_count, first_seen_height, last_seen_height) 
+VALUES ($1, $2, $3, $4, $4)
+ON CONFLICT (type, date) DO UPDATE 
+    SET messages_count = message_type_daily_stats.messages_count + excluded.messages_count,
+        first_seen_height = LEAST(message_type_daily_stats.first_seen_height, excluded.first_seen_height),
+        last_seen_height = GREATEST(message_type_daily_stats.last_seen_height, excluded.last_seen_height)`
+
+			_, err = tx.Exec(stmt, stat.Type, date, stat.MessagesCount, height)
+			if err != nil {
+				return fmt.Errorf("error while storing message type daily stats: %s", err)
+			}
+
+			if len(stat.Senders) == 0 {
+				continue
+			}
+
+			stmt = `
+INSERT INTO message_type_daily_sender (type, date, address) 
+SELECT $1::TEXT, $2::DATE, unnest($3::TEXT[])
+ON CONFLICT DO NOTHING`
+
+			res, err = tx.Exec(stmt, stat.Type, date, pq.Array(stat.Senders))
+			if err != nil {
+				return fmt.Errorf("error while storing message type daily senders: %s", err)
+			}
+
+			newSenders, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("error while getting message type daily senders rows: %s", err)
+			}
+
+			_, err = tx.Exec(`
+UPDATE message_type_daily_stats SET senders_count = senders_count + $3 
+WHERE type = $1 AND date = $2`, stat.Type, date, newSenders)
+			if err != nil {
+				return fmt.Errorf("error while updating message type daily senders count: %s", err)
+			}
+		}
 
-	_, err := db.SQL.Exec(stmt, msg.Type, msg.Module, msg.Label, msg.Height)
-	return err
+		return nil
+	})
 }
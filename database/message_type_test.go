@@ -0,0 +1,71 @@
+package database_test
+
+import (
+	"time"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveMessageType() {
+	msgType := "cosmos.bank.v1beta1.MsgSend"
+
+	err := suite.database.SaveMessageType(types.NewMessageType(msgType, "bank", "MsgSend", 10))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMessageType(types.NewMessageType(msgType, "bank", "MsgSend", 5))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMessageType(types.NewMessageType(msgType, "bank", "MsgSend", 20))
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		Height         int64 `db:"height"`
+		LastSeenHeight int64 `db:"last_seen_height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `SELECT height, last_seen_height FROM message_type`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(int64(5), rows[0].Height)
+	suite.Require().Equal(int64(20), rows[0].LastSeenHeight)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveMessageTypeBlockStats() {
+	msgType := "cosmos.bank.v1beta1.MsgSend"
+	first := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+	second := "cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a"
+	timestamp := time.Date(2020, 1, 1, 15, 0, 0, 0, time.UTC)
+
+	err := suite.database.SaveMessageType(types.NewMessageType(msgType, "bank", "MsgSend", 10))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMessageTypeBlockStats(10, timestamp, []types.MessageTypeBlockStats{
+		types.NewMessageTypeBlockStats(msgType, 2, []string{first}),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveMessageTypeBlockStats(11, timestamp.Add(time.Hour), []types.MessageTypeBlockStats{
+		types.NewMessageTypeBlockStats(msgType, 3, []string{first, second}),
+	})
+	suite.Require().NoError(err)
+
+	// Handling the same height twice should not change the data
+	err = suite.database.SaveMessageTypeBlockStats(11, timestamp.Add(time.Hour), []types.MessageTypeBlockStats{
+		types.NewMessageTypeBlockStats(msgType, 3, []string{first, second}),
+	})
+	suite.Require().NoError(err)
+
+	var rows []struct {
+		MessagesCount   int64 `db:"messages_count"`
+		SendersCount    int64 `db:"senders_count"`
+		FirstSeenHeight int64 `db:"first_seen_height"`
+		LastSeenHeight  int64 `db:"last_seen_height"`
+	}
+	err = suite.database.Sqlx.Select(&rows, `
+SELECT messages_count, senders_count, first_seen_height, last_seen_height FROM message_type_daily_stats`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(int64(5), rows[0].MessagesCount)
+	suite.Require().Equal(int64(2), rows[0].SendersCount)
+	suite.Require().Equal(int64(10), rows[0].FirstSeenHeight)
+	suite.Require().Equal(int64(11), rows[0].LastSeenHeight)
+}
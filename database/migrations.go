@@ -0,0 +1,321 @@
+package database
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// BaselineMigrationVersion represents the version of the migration creating the schema that was in place
+// before the migrations were introduced, which is frozen inside the migrations/baseline folder
+const BaselineMigrationVersion int64 = 0
+
+// LatestSchemaObject represents a table or index created by the latest migration, used to detect the databases
+// whose schema has been created by running the files inside the schema folder instead of the migrations.
+// It must be updated every time a new migration is added
+const LatestSchemaObject = "pruning_group"
+
+// migrationsLockID represents the id of the advisory lock preventing migrations from running concurrently
+const migrationsLockID = 7_123_456_789
+
+var (
+	//go:embed migrations
+	migrationsFS embed.FS
+
+	migrationFileRegExp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+)
+
+// Migration represents a single versioned schema migration
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// GetMigrations returns all the migrations embedded inside the binary sorted by version,
+// starting with the baseline one
+func GetMigrations() ([]Migration, error) {
+	baseline, err := getBaselineMigration()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("error while reading migrations: %s", err)
+	}
+
+	migrations := map[int64]*Migration{}
+	for _, entry := range entries {
+		parts := migrationFileRegExp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || parts == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || version <= BaselineMigrationVersion {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+
+		bz, err := migrationsFS.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error while reading migration %s: %s", entry.Name(), err)
+		}
+
+		migration, found := migrations[version]
+		if !found {
+			migration = &Migration{Version: version, Name: parts[2]}
+			migrations[version] = migration
+		}
+
+		if migration.Name != parts[2] {
+			return nil, fmt.Errorf("duplicated migration version: %d", version)
+		}
+
+		if parts[3] == "up" {
+			migration.Up = string(bz)
+		} else {
+			migration.Down = string(bz)
+		}
+	}
+
+	result := []Migration{baseline}
+	for _, migration := range migrations {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("missing up file for migration %d_%s", migration.Version, migration.Name)
+		}
+		result = append(result, *migration)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// getBaselineMigration returns the migration creating the schema frozen inside the migrations/baseline folder
+func getBaselineMigration() (Migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations/baseline")
+	if err != nil {
+		return Migration{}, fmt.Errorf("error while reading baseline schema: %s", err)
+	}
+
+	var up string
+	for _, entry := range entries {
+		bz, err := migrationsFS.ReadFile(path.Join("migrations/baseline", entry.Name()))
+		if err != nil {
+			return Migration{}, fmt.Errorf("error while reading baseline schema file %s: %s", entry.Name(), err)
+		}
+		up += string(bz) + "\n"
+	}
+
+	return Migration{Version: BaselineMigrationVersion, Name: "baseline", Up: up}, nil
+}
+
+// createSchemaMigrationsTable creates the table keeping track of the applied migrations, if it does not exist yet
+func createSchemaMigrationsTable(tx *sql.Tx) error {
+	stmt := `
+CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    version    BIGINT                      NOT NULL PRIMARY KEY,
+    name       TEXT                        NOT NULL,
+    applied_at TIMESTAMP WITHOUT TIME ZONE NOT NULL
+)`
+	_, err := tx.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error while creating schema migrations table: %s", err)
+	}
+
+	return nil
+}
+
+// queryMigrationVersions returns the migration versions returned by the given query using the given transaction
+func queryMigrationVersions(tx *sql.Tx, query string, args ...interface{}) ([]int64, error) {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting applied migrations: %s", err)
+	}
+	defer rows.Close()
+
+	var versions []int64
+	for rows.Next() {
+		var version int64
+		err = rows.Scan(&version)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning applied migration: %s", err)
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, rows.Err()
+}
+
+// GetAppliedMigrations returns all the migrations that have been applied to the database, sorted by version.
+// If the migrations have never been run, nil is returned instead
+func (db *Db) GetAppliedMigrations() ([]dbtypes.SchemaMigrationRow, error) {
+	var exists bool
+	err := db.SQL.QueryRow(`SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("error while checking schema migrations table: %s", err)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	var rows []dbtypes.SchemaMigrationRow
+	err = db.Sqlx.Select(&rows, `SELECT version, name, applied_at FROM schema_migrations ORDER BY version`)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting applied migrations: %s", err)
+	}
+
+	return rows, nil
+}
+
+// MigrateUp applies all the given migrations that have not been applied yet, returning the applied ones.
+// All the migrations are applied inside a single transaction, so that either all of them succeed or none does.
+// If the database has been created before the migrations were introduced, the baseline is only marked as applied
+// since its schema already exists, and all the other migrations are applied on top of it.
+// If the database has been created using the latest files inside the schema folder instead, all the migrations
+// are only marked as applied
+func (db *Db) MigrateUp(migrations []Migration) ([]Migration, error) {
+	tx, err := db.SQL.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error while beginning migrations transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, migrationsLockID)
+	if err != nil {
+		return nil, fmt.Errorf("error while acquiring migrations lock: %s", err)
+	}
+
+	err = createSchemaMigrationsTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := queryMigrationVersions(tx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[int64]bool{}
+	for _, version := range versions {
+		applied[version] = true
+	}
+
+	// Check if the schema is missing entirely, in which case the baseline has to be applied as well, or if it has
+	// been created using the files inside the schema folder, in which case it already contains all the migrations
+	var emptyDatabase, latestSchema bool
+	if !applied[BaselineMigrationVersion] {
+		err = tx.QueryRow(`SELECT to_regclass('block') IS NULL, to_regclass($1) IS NOT NULL`, LatestSchemaObject).
+			Scan(&emptyDatabase, &latestSchema)
+		if err != nil {
+			return nil, fmt.Errorf("error while checking existing schema: %s", err)
+		}
+	}
+
+	var result []Migration
+	for _, migration := range migrations {
+		if applied[migration.Version] {
+			continue
+		}
+
+		// The baseline is only run on empty databases, while all the other migrations are run unless the schema
+		// is already up to date
+		if !latestSchema && (migration.Version != BaselineMigrationVersion || emptyDatabase) {
+			_, err = tx.Exec(migration.Up)
+			if err != nil {
+				return nil, fmt.Errorf("error while applying migration %d_%s: %s", migration.Version, migration.Name, err)
+			}
+			result = append(result, migration)
+		}
+
+		_, err = tx.Exec(`INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, time.Now().UTC())
+		if err != nil {
+			return nil, fmt.Errorf("error while storing migration %d_%s: %s", migration.Version, migration.Name, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("error while committing migrations: %s", err)
+	}
+
+	return result, nil
+}
+
+// MigrateDown reverts the given number of latest applied migrations among the given ones, returning the reverted ones.
+// All the migrations are reverted inside a single transaction, so that either all of them succeed or none does
+func (db *Db) MigrateDown(migrations []Migration, steps int) ([]Migration, error) {
+	tx, err := db.SQL.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error while beginning migrations transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, migrationsLockID)
+	if err != nil {
+		return nil, fmt.Errorf("error while acquiring migrations lock: %s", err)
+	}
+
+	err = createSchemaMigrationsTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := queryMigrationVersions(tx,
+		`SELECT version FROM schema_migrations ORDER BY version DESC LIMIT $1`, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]Migration{}
+	for _, migration := range migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var result []Migration
+	for _, version := range versions {
+		if version == BaselineMigrationVersion {
+			return nil, fmt.Errorf("the baseline migration cannot be reverted")
+		}
+
+		migration, found := byVersion[version]
+		if !found {
+			return nil, fmt.Errorf("migration %d is not known to this version", version)
+		}
+
+		if migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s cannot be reverted", migration.Version, migration.Name)
+		}
+
+		_, err = tx.Exec(migration.Down)
+		if err != nil {
+			return nil, fmt.Errorf("error while reverting migration %d_%s: %s", migration.Version, migration.Name, err)
+		}
+
+		_, err = tx.Exec(`DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+		if err != nil {
+			return nil, fmt.Errorf("error while deleting migration %d_%s: %s", migration.Version, migration.Name, err)
+		}
+
+		result = append(result, migration)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("error while committing migrations: %s", err)
+	}
+
+	return result, nil
+}
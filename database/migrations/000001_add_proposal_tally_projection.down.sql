@@ -0,0 +1 @@
+DROP TABLE proposal_tally_projection;
@@ -0,0 +1,19 @@
+/*
+ * This holds the outcome each proposal would have if its voting period ended at the given height.
+ * It is computed combining the latest tally result, the staking pool snapshot and the gov params,
+ * and it is refreshed every time the tally result of a proposal is updated.
+ */
+CREATE TABLE proposal_tally_projection
+(
+    proposal_id      INTEGER REFERENCES proposal (id) PRIMARY KEY,
+    turnout          DECIMAL NOT NULL,
+    quorum           DECIMAL NOT NULL,
+    quorum_reached   BOOLEAN NOT NULL,
+    yes_ratio        DECIMAL NOT NULL,
+    threshold        DECIMAL NOT NULL,
+    veto_ratio       DECIMAL NOT NULL,
+    veto_threshold   DECIMAL NOT NULL,
+    projected_status TEXT    NOT NULL,
+    height           BIGINT  NOT NULL
+);
+CREATE INDEX proposal_tally_projection_height_index ON proposal_tally_projection (height);
@@ -0,0 +1,9 @@
+DROP FUNCTION search(TEXT, BIGINT);
+DROP INDEX account_address_pattern_index;
+DROP TABLE search_result;
+
+DROP INDEX proposal_search_vector_index;
+ALTER TABLE proposal DROP COLUMN search_vector;
+
+DROP INDEX validator_description_search_vector_index;
+ALTER TABLE validator_description DROP COLUMN search_vector;
@@ -0,0 +1 @@
+DROP TABLE upgrade_history;
@@ -0,0 +1,2 @@
+DROP TABLE params_history;
+DROP TABLE bank_params;
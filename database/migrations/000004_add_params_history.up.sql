@@ -0,0 +1,21 @@
+CREATE TABLE bank_params
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    params     JSONB   NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX bank_params_height_index ON bank_params (height);
+
+/**
+ * This table keeps track of every change of the parameters of each module.
+ * A new row is only stored when the parameters differ from the ones that were in place before.
+ */
+CREATE TABLE params_history
+(
+    module_name TEXT   NOT NULL,
+    params      JSONB  NOT NULL,
+    height      BIGINT NOT NULL,
+    PRIMARY KEY (module_name, height)
+);
+CREATE INDEX params_history_height_index ON params_history (height);
@@ -0,0 +1,2 @@
+DROP TABLE supply_projection;
+DROP TABLE inflation_history;
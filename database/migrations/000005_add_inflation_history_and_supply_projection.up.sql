@@ -0,0 +1,25 @@
+CREATE TABLE inflation_history
+(
+    height            BIGINT                      NOT NULL PRIMARY KEY,
+    inflation         DECIMAL                     NOT NULL,
+    annual_provisions DECIMAL                     NOT NULL,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX inflation_history_timestamp_index ON inflation_history (timestamp);
+
+/**
+ * This table contains the projected supply of the mint denom for each of the following days.
+ * The projection is computed from the current mint params, inflation, supply and bonded ratio, assuming that
+ * the bonded ratio does not change over time.
+ */
+CREATE TABLE supply_projection
+(
+    day               INTEGER                     NOT NULL PRIMARY KEY,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    projected_height  BIGINT                      NOT NULL,
+    inflation         DECIMAL                     NOT NULL,
+    annual_provisions DECIMAL                     NOT NULL,
+    supply            DECIMAL                     NOT NULL,
+    height            BIGINT                      NOT NULL
+);
+CREATE INDEX supply_projection_height_index ON supply_projection (height);
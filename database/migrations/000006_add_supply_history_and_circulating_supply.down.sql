@@ -0,0 +1,3 @@
+DROP TABLE circulating_supply_history;
+DROP TABLE circulating_supply;
+DROP TABLE supply_history;
@@ -0,0 +1,24 @@
+CREATE TABLE supply_history
+(
+    coins  COIN[] NOT NULL,
+    height BIGINT NOT NULL PRIMARY KEY
+);
+
+/*
+ * The circulating supply is computed as the total supply minus the amounts that are still locked inside vesting accounts,
+ * the community pool and the balances of the addresses that have been excluded inside the configuration
+ */
+CREATE TABLE circulating_supply
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    coins      COIN[]  NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX circulating_supply_height_index ON circulating_supply (height);
+
+CREATE TABLE circulating_supply_history
+(
+    coins  COIN[] NOT NULL,
+    height BIGINT NOT NULL PRIMARY KEY
+);
@@ -0,0 +1 @@
+DROP TABLE vesting_unlock_calendar;
@@ -0,0 +1,9 @@
+/* ---- unlock_date is the UTC day during which the amount is unlocked by the vesting accounts ---- */
+CREATE TABLE vesting_unlock_calendar
+(
+    unlock_date     DATE    NOT NULL PRIMARY KEY,
+    amount          COIN[]  NOT NULL DEFAULT '{}',
+    accounts_count  INTEGER NOT NULL,
+    height          BIGINT  NOT NULL
+);
+CREATE INDEX vesting_unlock_calendar_height_index ON vesting_unlock_calendar (height);
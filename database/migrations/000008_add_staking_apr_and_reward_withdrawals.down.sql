@@ -0,0 +1,5 @@
+DROP TABLE validator_apr_history;
+DROP TABLE validator_apr;
+DROP TABLE staking_apr_history;
+DROP TABLE staking_apr;
+DROP TABLE delegation_reward_withdrawal;
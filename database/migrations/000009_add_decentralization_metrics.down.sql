@@ -0,0 +1,2 @@
+DROP TABLE decentralization_metrics_history;
+DROP TABLE decentralization_metrics;
@@ -0,0 +1,2 @@
+DROP TABLE proposer_stats_block;
+DROP TABLE proposer_daily_stats;
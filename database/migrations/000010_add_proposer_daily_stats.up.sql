@@ -0,0 +1,19 @@
+CREATE TABLE proposer_daily_stats
+(
+    validator_address  TEXT    NOT NULL REFERENCES validator (consensus_address),
+    date               DATE    NOT NULL,
+    blocks_proposed    BIGINT  NOT NULL DEFAULT 0,
+    expected_proposals DECIMAL NOT NULL DEFAULT 0,
+    empty_blocks       BIGINT  NOT NULL DEFAULT 0,
+    total_gas          BIGINT  NOT NULL DEFAULT 0,
+    average_gas        DECIMAL NOT NULL DEFAULT 0,
+    height             BIGINT  NOT NULL,
+    PRIMARY KEY (validator_address, date)
+);
+CREATE INDEX proposer_daily_stats_date_index ON proposer_daily_stats (date);
+
+/* Heights whose blocks are already included inside proposer_daily_stats */
+CREATE TABLE proposer_stats_block
+(
+    height BIGINT NOT NULL PRIMARY KEY
+);
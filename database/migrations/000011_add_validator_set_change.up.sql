@@ -0,0 +1,15 @@
+CREATE TABLE validator_set_change
+(
+    validator_address     TEXT      NOT NULL REFERENCES validator (consensus_address),
+    type                  TEXT      NOT NULL,
+    previous_voting_power BIGINT    NOT NULL,
+    voting_power          BIGINT    NOT NULL,
+    voting_power_delta    BIGINT    NOT NULL,
+    status                INT       NOT NULL,
+    jailed                BOOLEAN   NOT NULL,
+    height                BIGINT    NOT NULL,
+    timestamp             TIMESTAMP NOT NULL,
+    PRIMARY KEY (validator_address, height)
+);
+CREATE INDEX validator_set_change_height_index ON validator_set_change (height);
+CREATE INDEX validator_set_change_type_index ON validator_set_change (type);
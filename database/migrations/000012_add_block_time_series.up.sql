@@ -0,0 +1,21 @@
+DROP TABLE average_block_time_per_minute;
+DROP TABLE average_block_time_per_hour;
+DROP TABLE average_block_time_per_day;
+
+CREATE INDEX block_timestamp_index ON block (timestamp);
+
+/* Block time statistics of the blocks produced inside each minute, hour or day */
+CREATE TABLE block_time_series
+(
+    period             TEXT      NOT NULL,
+    bucket             TIMESTAMP NOT NULL,
+    blocks_count       BIGINT    NOT NULL,
+    min_block_time     DECIMAL   NOT NULL,
+    max_block_time     DECIMAL   NOT NULL,
+    average_block_time DECIMAL   NOT NULL,
+    p95_block_time     DECIMAL   NOT NULL,
+    first_height       BIGINT    NOT NULL,
+    last_height        BIGINT    NOT NULL,
+    PRIMARY KEY (period, bucket)
+);
+CREATE INDEX block_time_series_bucket_index ON block_time_series (bucket);
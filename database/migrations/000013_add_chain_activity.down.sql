@@ -0,0 +1,4 @@
+DROP TABLE chain_activity_block;
+DROP TABLE chain_activity_signer;
+DROP TABLE chain_activity_fee;
+DROP TABLE chain_activity;
@@ -0,0 +1,41 @@
+CREATE TABLE chain_activity
+(
+    period         TEXT      NOT NULL,
+    bucket         TIMESTAMP NOT NULL,
+    txs_count      BIGINT    NOT NULL DEFAULT 0,
+    successful_txs BIGINT    NOT NULL DEFAULT 0,
+    failed_txs     BIGINT    NOT NULL DEFAULT 0,
+    active_signers BIGINT    NOT NULL DEFAULT 0,
+    new_accounts   BIGINT    NOT NULL DEFAULT 0,
+    gas_used       BIGINT    NOT NULL DEFAULT 0,
+    gas_wanted     BIGINT    NOT NULL DEFAULT 0,
+    height         BIGINT    NOT NULL,
+    PRIMARY KEY (period, bucket)
+);
+CREATE INDEX chain_activity_bucket_index ON chain_activity (bucket);
+
+CREATE TABLE chain_activity_fee
+(
+    period TEXT      NOT NULL,
+    bucket TIMESTAMP NOT NULL,
+    denom  TEXT      NOT NULL,
+    amount NUMERIC   NOT NULL,
+    PRIMARY KEY (period, bucket, denom),
+    FOREIGN KEY (period, bucket) REFERENCES chain_activity (period, bucket)
+);
+
+/* Signers of each bucket, used to count the unique active signers */
+CREATE TABLE chain_activity_signer
+(
+    period  TEXT      NOT NULL,
+    bucket  TIMESTAMP NOT NULL,
+    address TEXT      NOT NULL,
+    PRIMARY KEY (period, bucket, address),
+    FOREIGN KEY (period, bucket) REFERENCES chain_activity (period, bucket)
+);
+
+/* Heights whose blocks are already included inside chain_activity */
+CREATE TABLE chain_activity_block
+(
+    height BIGINT NOT NULL PRIMARY KEY
+);
@@ -0,0 +1,4 @@
+DROP TABLE message_type_stats_block;
+DROP TABLE message_type_daily_sender;
+DROP TABLE message_type_daily_stats;
+ALTER TABLE message_type DROP COLUMN last_seen_height;
@@ -0,0 +1,35 @@
+ALTER TABLE message_type ADD COLUMN last_seen_height BIGINT NOT NULL DEFAULT 0;
+UPDATE message_type SET last_seen_height = height;
+UPDATE message_type
+SET last_seen_height = last_seen.height
+FROM (SELECT type, max(height) AS height FROM message GROUP BY type) AS last_seen
+WHERE last_seen.type = message_type.type
+  AND last_seen.height > message_type.last_seen_height;
+
+CREATE TABLE message_type_daily_stats
+(
+    type              TEXT   NOT NULL REFERENCES message_type (type),
+    date              DATE   NOT NULL,
+    messages_count    BIGINT NOT NULL DEFAULT 0,
+    senders_count     BIGINT NOT NULL DEFAULT 0,
+    first_seen_height BIGINT NOT NULL,
+    last_seen_height  BIGINT NOT NULL,
+    PRIMARY KEY (type, date)
+);
+CREATE INDEX message_type_daily_stats_date_index ON message_type_daily_stats (date);
+
+/* Senders of each message type per day, used to count the distinct senders */
+CREATE TABLE message_type_daily_sender
+(
+    type    TEXT NOT NULL,
+    date    DATE NOT NULL,
+    address TEXT NOT NULL,
+    PRIMARY KEY (type, date, address),
+    FOREIGN KEY (type, date) REFERENCES message_type_daily_stats (type, date)
+);
+
+/* Heights whose blocks are already included inside message_type_daily_stats */
+CREATE TABLE message_type_stats_block
+(
+    height BIGINT NOT NULL PRIMARY KEY
+);
@@ -0,0 +1,4 @@
+DROP TABLE gas_price_hourly;
+DROP TABLE fee_market_hourly;
+DROP TABLE gas_price_block;
+DROP TABLE fee_market_block;
@@ -0,0 +1,45 @@
+CREATE TABLE fee_market_block
+(
+    height         BIGINT    NOT NULL PRIMARY KEY REFERENCES block (height),
+    txs_count      BIGINT    NOT NULL,
+    gas_used       BIGINT    NOT NULL,
+    gas_wanted     BIGINT    NOT NULL,
+    gas_efficiency DECIMAL   NOT NULL,
+    out_of_gas_txs BIGINT    NOT NULL,
+    timestamp      TIMESTAMP NOT NULL
+);
+CREATE INDEX fee_market_block_timestamp_index ON fee_market_block (timestamp);
+
+/* Effective gas prices (fee amount / gas wanted) paid inside each block, per fee denom */
+CREATE TABLE gas_price_block
+(
+    height           BIGINT  NOT NULL REFERENCES block (height),
+    denom            TEXT    NOT NULL,
+    txs_count        BIGINT  NOT NULL,
+    min_gas_price    DECIMAL NOT NULL,
+    median_gas_price DECIMAL NOT NULL,
+    p90_gas_price    DECIMAL NOT NULL,
+    PRIMARY KEY (height, denom)
+);
+
+CREATE TABLE fee_market_hourly
+(
+    bucket         TIMESTAMP NOT NULL PRIMARY KEY,
+    txs_count      BIGINT    NOT NULL,
+    gas_used       BIGINT    NOT NULL,
+    gas_wanted     BIGINT    NOT NULL,
+    gas_efficiency DECIMAL   NOT NULL,
+    out_of_gas_txs BIGINT    NOT NULL
+);
+
+CREATE TABLE gas_price_hourly
+(
+    bucket           TIMESTAMP NOT NULL,
+    denom            TEXT      NOT NULL,
+    txs_count        BIGINT    NOT NULL,
+    min_gas_price    DECIMAL   NOT NULL,
+    median_gas_price DECIMAL   NOT NULL,
+    p90_gas_price    DECIMAL   NOT NULL,
+    PRIMARY KEY (bucket, denom)
+);
+CREATE INDEX gas_price_hourly_denom_index ON gas_price_hourly (denom);
@@ -0,0 +1,30 @@
+DROP TABLE fee_grant_usage;
+
+/* Only the latest active allowance of each grantee and granter pair can be kept */
+DELETE FROM fee_grant_allowance WHERE status <> 'active';
+DELETE FROM fee_grant_allowance
+WHERE id IN (
+    SELECT id
+    FROM (SELECT id, row_number() OVER (PARTITION BY grantee_address, granter_address ORDER BY height DESC) AS position
+          FROM fee_grant_allowance) AS allowances
+    WHERE position > 1
+);
+
+DROP INDEX fee_grant_allowance_expiration_index;
+DROP INDEX fee_grant_allowance_status_index;
+ALTER TABLE fee_grant_allowance DROP CONSTRAINT unique_fee_grant_allowance;
+ALTER TABLE fee_grant_allowance
+    ADD CONSTRAINT unique_fee_grant_allowance UNIQUE (grantee_address, granter_address);
+
+ALTER TABLE fee_grant_allowance
+    DROP COLUMN remaining_allowance,
+    DROP COLUMN spend_limit,
+    DROP COLUMN period_spend_limit,
+    DROP COLUMN period_can_spend,
+    DROP COLUMN period_reset,
+    DROP COLUMN expiration,
+    DROP COLUMN allowed_messages,
+    DROP COLUMN spent,
+    DROP COLUMN last_used_height,
+    DROP COLUMN status,
+    DROP COLUMN end_height;
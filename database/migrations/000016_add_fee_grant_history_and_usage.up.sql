@@ -0,0 +1,78 @@
+ALTER TABLE fee_grant_allowance
+    ADD COLUMN remaining_allowance JSONB  NOT NULL DEFAULT '{}'::JSONB,
+    ADD COLUMN spend_limit         COIN[],
+    ADD COLUMN period_spend_limit  COIN[],
+    ADD COLUMN period_can_spend    COIN[],
+    ADD COLUMN period_reset        TIMESTAMP,
+    ADD COLUMN expiration          TIMESTAMP,
+    ADD COLUMN allowed_messages    TEXT[],
+    ADD COLUMN spent               COIN[] NOT NULL DEFAULT '{}',
+    ADD COLUMN last_used_height    BIGINT,
+    ADD COLUMN status              TEXT   NOT NULL DEFAULT 'active',
+    ADD COLUMN end_height          BIGINT;
+
+/* Revoked allowances used to be deleted, so all the existing ones are active and have never been used */
+WITH inner_allowance AS (
+    SELECT id,
+           CASE WHEN allowance ->> '@type' = '/cosmos.feegrant.v1beta1.AllowedMsgAllowance'
+                THEN allowance -> 'allowance'
+                ELSE allowance
+           END AS value,
+           CASE WHEN allowance ->> '@type' = '/cosmos.feegrant.v1beta1.AllowedMsgAllowance'
+                THEN ARRAY(SELECT jsonb_array_elements_text(allowance -> 'allowed_messages'))
+           END AS allowed_messages
+    FROM fee_grant_allowance
+),
+limits AS (
+    SELECT id,
+           allowed_messages,
+           CASE WHEN value ->> '@type' = '/cosmos.feegrant.v1beta1.PeriodicAllowance'
+                THEN value -> 'basic'
+                ELSE value
+           END AS basic,
+           CASE WHEN value ->> '@type' = '/cosmos.feegrant.v1beta1.PeriodicAllowance'
+                THEN value
+           END AS periodic
+    FROM inner_allowance
+)
+UPDATE fee_grant_allowance
+SET remaining_allowance = fee_grant_allowance.allowance,
+    spend_limit         = NULLIF(ARRAY(
+        SELECT ROW (coin ->> 'denom', coin ->> 'amount')::COIN
+        FROM jsonb_array_elements(CASE WHEN jsonb_typeof(limits.basic -> 'spend_limit') = 'array'
+                                       THEN limits.basic -> 'spend_limit' END) AS coin), '{}'),
+    period_spend_limit  = NULLIF(ARRAY(
+        SELECT ROW (coin ->> 'denom', coin ->> 'amount')::COIN
+        FROM jsonb_array_elements(CASE WHEN jsonb_typeof(limits.periodic -> 'period_spend_limit') = 'array'
+                                       THEN limits.periodic -> 'period_spend_limit' END) AS coin), '{}'),
+    period_can_spend    = NULLIF(ARRAY(
+        SELECT ROW (coin ->> 'denom', coin ->> 'amount')::COIN
+        FROM jsonb_array_elements(CASE WHEN jsonb_typeof(limits.periodic -> 'period_can_spend') = 'array'
+                                       THEN limits.periodic -> 'period_can_spend' END) AS coin), '{}'),
+    period_reset        = (limits.periodic ->> 'period_reset')::TIMESTAMPTZ AT TIME ZONE 'UTC',
+    expiration          = (limits.basic ->> 'expiration')::TIMESTAMPTZ AT TIME ZONE 'UTC',
+    allowed_messages    = limits.allowed_messages
+FROM limits
+WHERE limits.id = fee_grant_allowance.id;
+
+ALTER TABLE fee_grant_allowance DROP CONSTRAINT unique_fee_grant_allowance;
+ALTER TABLE fee_grant_allowance
+    ADD CONSTRAINT unique_fee_grant_allowance UNIQUE (grantee_address, granter_address, height);
+CREATE INDEX fee_grant_allowance_status_index ON fee_grant_allowance (status);
+CREATE INDEX fee_grant_allowance_expiration_index ON fee_grant_allowance (expiration);
+
+/* Fees paid by granters on behalf of grantees */
+CREATE TABLE fee_grant_usage
+(
+    transaction_hash TEXT      NOT NULL PRIMARY KEY,
+    allowance_id     INT REFERENCES fee_grant_allowance (id),
+    grantee_address  TEXT      NOT NULL REFERENCES account (address),
+    granter_address  TEXT      NOT NULL REFERENCES account (address),
+    fee              COIN[]    NOT NULL DEFAULT '{}',
+    height           BIGINT    NOT NULL,
+    timestamp        TIMESTAMP NOT NULL
+);
+CREATE INDEX fee_grant_usage_allowance_id_index ON fee_grant_usage (allowance_id);
+CREATE INDEX fee_grant_usage_grantee_address_index ON fee_grant_usage (grantee_address);
+CREATE INDEX fee_grant_usage_granter_address_index ON fee_grant_usage (granter_address);
+CREATE INDEX fee_grant_usage_height_index ON fee_grant_usage (height);
@@ -0,0 +1,2 @@
+DROP TABLE gap_detector_state;
+DROP TABLE missing_block;
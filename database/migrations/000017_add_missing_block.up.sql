@@ -0,0 +1,18 @@
+/* Heights found missing inside the block table, that are refetched until they are stored */
+CREATE TABLE missing_block
+(
+    height        BIGINT    NOT NULL PRIMARY KEY,
+    retry_count   INT       NOT NULL DEFAULT 0,
+    last_error    TEXT,
+    next_retry_at TIMESTAMP NOT NULL,
+    detected_at   TIMESTAMP NOT NULL
+);
+CREATE INDEX missing_block_next_retry_at_index ON missing_block (next_retry_at);
+
+/* Latest height up to which the block table has been scanned for missing heights */
+CREATE TABLE gap_detector_state
+(
+    one_row_id          BOOL   NOT NULL DEFAULT TRUE PRIMARY KEY,
+    last_scanned_height BIGINT NOT NULL,
+    CHECK (one_row_id)
+);
@@ -0,0 +1,9 @@
+/* Progress of the modules backfills, used to resume them from where they stopped */
+CREATE TABLE backfill_checkpoint
+(
+    modules      TEXT      NOT NULL,
+    start_height BIGINT    NOT NULL,
+    last_height  BIGINT    NOT NULL,
+    updated_at   TIMESTAMP NOT NULL,
+    PRIMARY KEY (modules, start_height)
+);
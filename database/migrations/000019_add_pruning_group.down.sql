@@ -0,0 +1,2 @@
+DROP TABLE pruning_group;
+DROP INDEX message_height_index;
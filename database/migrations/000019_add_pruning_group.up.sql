@@ -0,0 +1,8 @@
+CREATE INDEX message_height_index ON message (height);
+
+/* Height below which the data of each group of tables has been pruned according to its retention policy */
+CREATE TABLE pruning_group
+(
+    group_name    TEXT   NOT NULL PRIMARY KEY,
+    pruned_height BIGINT NOT NULL
+);
@@ -0,0 +1,4 @@
+CREATE TABLE modules
+(
+    module_name TEXT NOT NULL UNIQUE PRIMARY KEY
+);
\ No newline at end of file
@@ -1,10 +1,13 @@
 package database
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/forbole/callisto/v4/types"
 )
@@ -19,12 +22,57 @@ ON CONFLICT (one_row_id) DO UPDATE
         height = excluded.height 
 WHERE inflation.height <= excluded.height`
 
-	_, err := db.SQL.Exec(stmt, inflation.String(), height)
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, inflation.String(), height)
+		if err != nil {
+			return fmt.Errorf("error while storing inflation: %s", err)
+		}
+		return nil
+	})
+}
+
+// SaveInflationHistory allows to store the inflation and annual provisions for the given block height and timestamp
+// inside the inflation history
+func (db *Db) SaveInflationHistory(
+	inflation sdk.Dec, annualProvisions sdk.Dec, height int64, timestamp time.Time,
+) error {
+	stmt := `
+INSERT INTO inflation_history (inflation, annual_provisions, height, timestamp) 
+VALUES ($1, $2, $3, $4) 
+ON CONFLICT (height) DO UPDATE 
+    SET inflation = excluded.inflation, 
+        annual_provisions = excluded.annual_provisions,
+        timestamp = excluded.timestamp`
+
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, inflation.String(), annualProvisions.String(), height, timestamp)
+		if err != nil {
+			return fmt.Errorf("error while storing inflation history: %s", err)
+		}
+		return nil
+	})
+}
+
+// GetLatestAnnualProvisions returns the most recent annual provisions stored inside the inflation history,
+// or nil if no inflation history has been stored yet
+func (db *Db) GetLatestAnnualProvisions() (*sdk.Dec, error) {
+	var rows []string
+	stmt := `SELECT annual_provisions::TEXT FROM inflation_history ORDER BY height DESC LIMIT 1`
+	err := db.Sqlx.Select(&rows, stmt)
 	if err != nil {
-		return fmt.Errorf("error while storing inflation: %s", err)
+		return nil, fmt.Errorf("error while getting annual provisions: %s", err)
 	}
 
-	return nil
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	annualProvisions, err := sdk.NewDecFromStr(rows[0])
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing annual provisions: %s", err)
+	}
+
+	return &annualProvisions, nil
 }
 
 // SaveMintParams allows to store the given params inside the database
@@ -42,9 +90,55 @@ ON CONFLICT (one_row_id) DO UPDATE
         height = excluded.height
 WHERE mint_params.height <= excluded.height`
 
-	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
+	return db.execBlockOperation(params.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, string(paramsBz), params.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing mint params: %s", err)
+		}
+		return saveParamsHistory(tx, minttypes.ModuleName, paramsBz, params.Height)
+	})
+}
+
+// SaveSupplyProjection allows to store the given supply projection, replacing the one that is currently stored
+// if it has been computed at a lower height
+func (db *Db) SaveSupplyProjection(projection []types.SupplyProjection) error {
+	if len(projection) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO supply_projection (day, timestamp, projected_height, inflation, annual_provisions, supply, height) 
+VALUES `
+
+	var params []interface{}
+	for i, entry := range projection {
+		ai := i * 7
+		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7)
+		params = append(params,
+			entry.Day,
+			entry.Timestamp,
+			entry.ProjectedHeight,
+			entry.Inflation.String(),
+			entry.AnnualProvisions.String(),
+			entry.Supply.String(),
+			entry.Height,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (day) DO UPDATE 
+    SET timestamp = excluded.timestamp,
+        projected_height = excluded.projected_height,
+        inflation = excluded.inflation,
+        annual_provisions = excluded.annual_provisions,
+        supply = excluded.supply,
+        height = excluded.height
+WHERE supply_projection.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt, params...)
 	if err != nil {
-		return fmt.Errorf("error while storing mint params: %s", err)
+		return fmt.Errorf("error while storing supply projection: %s", err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package database_test
 
 import (
 	"encoding/json"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -95,3 +96,72 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveMintParams() {
 	suite.Require().Equal(mintParams, storedParams)
 	suite.Require().Equal(int64(10), rows[0].Height)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveInflationHistory() {
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	// Save the data
+	err := suite.database.SaveInflationHistory(sdk.NewDecWithPrec(10, 2), sdk.NewDec(1000), 100, timestamp)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveInflationHistory(sdk.NewDecWithPrec(11, 2), sdk.NewDec(1100), 101, timestamp.Add(time.Second))
+	suite.Require().NoError(err)
+
+	// Verify the data
+	var rows []dbtypes.InflationHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM inflation_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().True(rows[0].Equal(dbtypes.NewInflationHistoryRow(0.10, 1000, 100, timestamp)))
+	suite.Require().True(rows[1].Equal(dbtypes.NewInflationHistoryRow(0.11, 1100, 101, timestamp.Add(time.Second))))
+
+	// Try updating an existing height
+	err = suite.database.SaveInflationHistory(sdk.NewDecWithPrec(12, 2), sdk.NewDec(1200), 101, timestamp.Add(time.Second))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.InflationHistoryRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM inflation_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().True(rows[1].Equal(dbtypes.NewInflationHistoryRow(0.12, 1200, 101, timestamp.Add(time.Second))))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveSupplyProjection() {
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	// Save the data
+	err := suite.database.SaveSupplyProjection([]types.SupplyProjection{
+		types.NewSupplyProjection(1, timestamp, 110, sdk.NewDecWithPrec(10, 2), sdk.NewDec(100), sdk.NewDec(1000), 100),
+		types.NewSupplyProjection(2, timestamp.Add(24*time.Hour), 120, sdk.NewDecWithPrec(11, 2), sdk.NewDec(110), sdk.NewDec(1100), 100),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.SupplyProjectionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM supply_projection ORDER BY day`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(float64(1100), rows[1].Supply)
+
+	// Try updating with a lower height
+	err = suite.database.SaveSupplyProjection([]types.SupplyProjection{
+		types.NewSupplyProjection(2, timestamp.Add(24*time.Hour), 120, sdk.NewDecWithPrec(11, 2), sdk.NewDec(110), sdk.NewDec(900), 90),
+	})
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.SupplyProjectionRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM supply_projection ORDER BY day`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(float64(1100), rows[1].Supply)
+
+	// Try updating with a higher height
+	err = suite.database.SaveSupplyProjection([]types.SupplyProjection{
+		types.NewSupplyProjection(2, timestamp.Add(24*time.Hour), 130, sdk.NewDecWithPrec(11, 2), sdk.NewDec(110), sdk.NewDec(1200), 110),
+	})
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.SupplyProjectionRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM supply_projection ORDER BY day`)
+	suite.Require().NoError(err)
+	suite.Require().Equal(float64(1200), rows[1].Supply)
+	suite.Require().Equal(int64(110), rows[1].Height)
+}
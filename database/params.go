@@ -0,0 +1,29 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// saveParamsHistory stores the given params of the module having the given name inside the params history
+// using the given transaction.
+// The params are stored only if they are different from the ones that were in place at the given height
+func saveParamsHistory(tx *sql.Tx, moduleName string, paramsBz []byte, height int64) error {
+	stmt := `
+INSERT INTO params_history (module_name, params, height)
+SELECT $1::TEXT, $2::JSONB, $3::BIGINT
+WHERE $2::JSONB IS DISTINCT FROM (
+    SELECT params FROM params_history 
+    WHERE module_name = $1 AND height <= $3 
+    ORDER BY height DESC LIMIT 1
+)
+ON CONFLICT (module_name, height) DO UPDATE 
+    SET params = excluded.params`
+
+	_, err := tx.Exec(stmt, moduleName, string(paramsBz), height)
+	if err != nil {
+		return fmt.Errorf("error while storing %s params history: %s", moduleName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package database_test
+
+import (
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_ParamsHistory() {
+	params := slashingtypes.Params{
+		SignedBlocksWindow:      10,
+		MinSignedPerWindow:      sdk.NewDecWithPrec(100, 2),
+		DowntimeJailDuration:    10000,
+		SlashFractionDoubleSign: sdk.NewDecWithPrec(100, 2),
+		SlashFractionDowntime:   sdk.NewDecWithPrec(100, 4),
+	}
+	err := suite.database.SaveSlashingParams(types.NewSlashingParams(params, 10))
+	suite.Require().NoError(err)
+
+	// Storing the same params again should not add a new history row
+	err = suite.database.SaveSlashingParams(types.NewSlashingParams(params, 20))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ParamsHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM params_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(slashingtypes.ModuleName, rows[0].ModuleName)
+	suite.Require().Equal(int64(10), rows[0].Height)
+
+	// Storing different params should add a new history row
+	params.SignedBlocksWindow = 20
+	err = suite.database.SaveSlashingParams(types.NewSlashingParams(params, 30))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ParamsHistoryRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM params_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(slashingtypes.ModuleName, rows[1].ModuleName)
+	suite.Require().Equal(int64(30), rows[1].Height)
+}
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// PartitionedTables contains the tables partitioned by height, in the order in which their partitions
+// should be archived. Messages come first since they reference the transactions
+var PartitionedTables = []string{"message", "transaction"}
+
+// GetPartitionName returns the name of the partition having the given id of the given table
+func GetPartitionName(table string, partitionID int64) string {
+	return fmt.Sprintf("%s_%d", table, partitionID)
+}
+
+// GetPartitionID returns the id of the partition of the given table having the given name,
+// or false if the name does not match the one of the partitions created by the parser
+func GetPartitionID(table string, name string) (int64, bool) {
+	value, found := strings.CutPrefix(name, table+"_")
+	if !found {
+		return 0, false
+	}
+
+	partitionID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return partitionID, true
+}
+
+// GetPartitions returns all the partitions attached to the given table, sorted by name
+func (db *Db) GetPartitions(table string) ([]dbtypes.PartitionRow, error) {
+	stmt := `
+SELECT child.relname                                 AS name,
+       pg_get_expr(child.relpartbound, child.oid)    AS bound,
+       pg_total_relation_size(child.oid)             AS size,
+       GREATEST(child.reltuples, 0)::BIGINT          AS rows_estimate
+FROM pg_inherits
+JOIN pg_class parent ON pg_inherits.inhparent = parent.oid
+JOIN pg_class child ON pg_inherits.inhrelid = child.oid
+JOIN pg_namespace ON parent.relnamespace = pg_namespace.oid
+WHERE parent.relname = $1 AND pg_namespace.nspname = current_schema()
+ORDER BY child.relname`
+
+	var rows []dbtypes.PartitionRow
+	err := db.Sqlx.Select(&rows, stmt, table)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting %s partitions: %s", table, err)
+	}
+
+	return rows, nil
+}
+
+// GetPartitionHeightRange returns the lowest and highest heights stored inside the given partition
+func (db *Db) GetPartitionHeightRange(partition string) (dbtypes.PartitionHeightRange, error) {
+	stmt := fmt.Sprintf(`SELECT MIN(height) AS min_height, MAX(height) AS max_height FROM %s`, partition)
+
+	var heightRange dbtypes.PartitionHeightRange
+	err := db.Sqlx.Get(&heightRange, stmt)
+	if err != nil {
+		return dbtypes.PartitionHeightRange{}, fmt.Errorf("error while getting %s height range: %s", partition, err)
+	}
+
+	return heightRange, nil
+}
+
+// ArchivePartition detaches the partition having the given id from the given table, and renames it
+// so that its data is kept but no longer queried, and a new partition with the same id can be created later.
+// The archived partition keeps its data as is, without the foreign keys to the tables it was referencing
+func (db *Db) ArchivePartition(table string, partitionID int64) error {
+	partition := GetPartitionName(table, partitionID)
+
+	tx, err := db.SQL.Begin()
+	if err != nil {
+		return fmt.Errorf("error while beginning partition archive transaction: %s", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(fmt.Sprintf(`ALTER TABLE %s DETACH PARTITION %s`, table, partition))
+	if err != nil {
+		return fmt.Errorf("error while detaching partition %s: %s", partition, err)
+	}
+
+	// Drop the foreign keys kept by the detached partition, so that it does not prevent archiving
+	// the partitions it references (eg. messages referencing transactions) or pruning the blocks
+	var constraints []string
+	rows, err := tx.Query(`SELECT conname FROM pg_constraint WHERE conrelid = $1::regclass AND contype = 'f'`, partition)
+	if err != nil {
+		return fmt.Errorf("error while getting partition %s foreign keys: %s", partition, err)
+	}
+	for rows.Next() {
+		var constraint string
+		if err = rows.Scan(&constraint); err != nil {
+			rows.Close()
+			return fmt.Errorf("error while scanning partition %s foreign key: %s", partition, err)
+		}
+		constraints = append(constraints, constraint)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error while getting partition %s foreign keys: %s", partition, err)
+	}
+
+	for _, constraint := range constraints {
+		_, err = tx.Exec(fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT %s`, partition, pq.QuoteIdentifier(constraint)))
+		if err != nil {
+			return fmt.Errorf("error while dropping partition %s foreign key %s: %s", partition, constraint, err)
+		}
+	}
+
+	_, err = tx.Exec(fmt.Sprintf(`ALTER TABLE %s RENAME TO %s_archive`, partition, partition))
+	if err != nil {
+		return fmt.Errorf("error while renaming partition %s: %s", partition, err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,99 @@
+package database_test
+
+import (
+	"github.com/forbole/callisto/v4/database"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_ArchivePartition() {
+	for _, partitionID := range []int64{0, 1} {
+		for _, table := range database.PartitionedTables {
+			err := suite.database.CreatePartitionIfNotExists(table, partitionID)
+			suite.Require().NoError(err)
+		}
+	}
+
+	partitions, err := suite.database.GetPartitions("transaction")
+	suite.Require().NoError(err)
+	suite.Require().Len(partitions, 2)
+	suite.Require().Equal("transaction_0", partitions[0].Name)
+	suite.Require().Equal("transaction_1", partitions[1].Name)
+
+	partitionID, ok := database.GetPartitionID("transaction", partitions[1].Name)
+	suite.Require().True(ok)
+	suite.Require().Equal(int64(1), partitionID)
+
+	_, ok = database.GetPartitionID("transaction", "transaction_1_archive")
+	suite.Require().False(ok)
+
+	heightRange, err := suite.database.GetPartitionHeightRange("transaction_0")
+	suite.Require().NoError(err)
+	suite.Require().False(heightRange.MinHeight.Valid)
+	suite.Require().False(heightRange.MaxHeight.Valid)
+
+	for _, table := range database.PartitionedTables {
+		err = suite.database.ArchivePartition(table, 0)
+		suite.Require().NoError(err)
+	}
+
+	partitions, err = suite.database.GetPartitions("transaction")
+	suite.Require().NoError(err)
+	suite.Require().Len(partitions, 1)
+	suite.Require().Equal("transaction_1", partitions[0].Name)
+
+	// The archived partition should be kept
+	var exists bool
+	err = suite.database.SQL.QueryRow(`SELECT to_regclass('transaction_0_archive') IS NOT NULL`).Scan(&exists)
+	suite.Require().NoError(err)
+	suite.Require().True(exists)
+
+	// A new partition with the same id can be created again
+	err = suite.database.CreatePartitionIfNotExists("transaction", 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_ArchivePartition_LinkedRows() {
+	suite.getBlock(10)
+	for _, table := range database.PartitionedTables {
+		err := suite.database.CreatePartitionIfNotExists(table, 0)
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.database.SQL.Exec(`
+INSERT INTO transaction (hash, height, success, signatures, partition_id) VALUES ('TX_HASH', 10, true, '{}', 0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO message_type (type, module, label, height) VALUES ('cosmos.bank.v1beta1.MsgSend', 'bank', 'MsgSend', 10)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO message (transaction_hash, index, type, value, involved_accounts_addresses, partition_id, height) 
+VALUES ('TX_HASH', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 0, 10)`)
+	suite.Require().NoError(err)
+
+	for _, table := range database.PartitionedTables {
+		err = suite.database.ArchivePartition(table, 0)
+		suite.Require().NoError(err)
+	}
+
+	// The archived rows should be kept
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM message_0_archive`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM transaction_0_archive`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	// The archived partitions should not reference other tables anymore
+	err = suite.database.SQL.QueryRow(`
+SELECT COUNT(*) FROM pg_constraint 
+WHERE conrelid IN ('message_0_archive'::regclass, 'transaction_0_archive'::regclass) AND contype = 'f'`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Zero(count)
+
+	// The block referenced by the archived transaction can be deleted
+	_, err = suite.database.SQL.Exec(`DELETE FROM block WHERE height = 10`)
+	suite.Require().NoError(err)
+}
@@ -1,110 +1,130 @@
 package database
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	PruningGroupPreCommits   = "pre_commits"
+	PruningGroupMessages     = "messages"
+	PruningGroupTransactions = "transactions"
+	PruningGroupHistory      = "history"
+	PruningGroupBlockStats   = "block_stats"
+)
+
+// PruningGroups contains the names of the groups of tables that can be pruned, in the order in which they are pruned
+var PruningGroups = []string{
+	PruningGroupPreCommits,
+	PruningGroupMessages,
+	PruningGroupTransactions,
+	PruningGroupHistory,
+	PruningGroupBlockStats,
+}
+
+// pruningTables contains the tables belonging to each pruning group, in the order in which they are pruned.
+// The tables that only contain the latest state are never pruned, since their rows are never replaced
+// by newer ones once they are deleted.
+// The tables keeping track of the heights already included inside the daily statistics (eg. chain_activity_block)
+// are never pruned either, since they prevent the statistics from counting the same height twice when it is replayed.
+// Pruning the transactions prunes their messages as well, since messages reference them
+var pruningTables = map[string][]string{
+	PruningGroupPreCommits:   {"pre_commit"},
+	PruningGroupMessages:     {"message"},
+	PruningGroupTransactions: {"message", "transaction"},
+	PruningGroupHistory: {
+		"supply_history", "circulating_supply_history", "decentralization_metrics_history", "inflation_history",
+		"staking_apr_history", "validator_apr_history", "validator_set_change",
+	},
+	PruningGroupBlockStats: {"gas_price_block", "fee_market_block"},
+}
 
 // Prune implements db.PruningDb
 func (db *Db) Prune(height int64) error {
-	// Prune default tables
-	err := db.Database.Prune(height)
-	if err != nil {
-		return fmt.Errorf("error while pruning db: %s", err)
-	}
-
-	// Prune modules
-	err = db.pruneBank(height)
-	if err != nil {
-		return fmt.Errorf("error while pruning bank: %s", err)
-	}
-
-	err = db.pruneStaking(height)
-	if err != nil {
-		return fmt.Errorf("error while pruning staking: %s", err)
-	}
-
-	err = db.pruneMint(height)
-	if err != nil {
-		return fmt.Errorf("error while pruning mint: %s", err)
-	}
-
-	err = db.pruneDistribution(height)
-	if err != nil {
-		return fmt.Errorf("error while pruning distribution: %s", err)
-	}
-
-	err = db.pruneSlashing(height)
-	if err != nil {
-		return fmt.Errorf("error while pruning slashing: %s", err)
+	for _, group := range []string{PruningGroupPreCommits, PruningGroupMessages} {
+		_, err := db.PruneGroup(group, height, height+1)
+		if err != nil {
+			return fmt.Errorf("error while pruning db: %s", err)
+		}
 	}
 
 	return nil
 }
 
-func (db *Db) pruneBank(height int64) error {
-	_, err := db.SQL.Exec(`DELETE FROM supply WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning supply: %s", err)
+// PruneGroup deletes the rows of all the tables belonging to the given pruning group having a height
+// between the given from height (included) and to height (excluded).
+// It returns the number of deleted rows of each table
+func (db *Db) PruneGroup(group string, fromHeight, toHeight int64) (map[string]int64, error) {
+	tables, found := pruningTables[group]
+	if !found {
+		return nil, fmt.Errorf("invalid pruning group: %s", group)
 	}
-	return nil
-}
 
-func (db *Db) pruneStaking(height int64) error {
-	_, err := db.SQL.Exec(`DELETE FROM staking_pool WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning staking pool: %s", err)
-	}
-
-	_, err = db.SQL.Exec(`DELETE FROM validator_commission WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning validator commission: %s", err)
-	}
+	pruned := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		stmt := fmt.Sprintf(`DELETE FROM %s WHERE height >= $1 AND height < $2`, table)
+		res, err := db.SQL.Exec(stmt, fromHeight, toHeight)
+		if err != nil {
+			return nil, fmt.Errorf("error while pruning %s: %s", table, err)
+		}
 
-	_, err = db.SQL.Exec(`DELETE FROM validator_voting_power WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning validator voting power: %s", err)
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("error while getting %s pruned rows: %s", table, err)
+		}
+		pruned[table] += rows
 	}
 
-	_, err = db.SQL.Exec(`DELETE FROM validator_status WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning validator status: %s", err)
-	}
+	return pruned, nil
+}
 
-	_, err = db.SQL.Exec(`DELETE FROM double_sign_vote WHERE height = $1`, height)
+// GetPrunedHeight returns the height below which the data of the given pruning group has been pruned,
+// or 0 if it has never been pruned
+func (db *Db) GetPrunedHeight(group string) (int64, error) {
+	var heights []int64
+	err := db.Sqlx.Select(&heights, `SELECT pruned_height FROM pruning_group WHERE group_name = $1`, group)
 	if err != nil {
-		return fmt.Errorf("error while pruning double sign votes: %s", err)
+		return 0, fmt.Errorf("error while getting %s pruned height: %s", group, err)
 	}
 
-	_, err = db.SQL.Exec(`DELETE FROM double_sign_evidence WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning double sign evidence: %s", err)
+	if len(heights) == 0 {
+		return 0, nil
 	}
 
-	return nil
+	return heights[0], nil
 }
 
-func (db *Db) pruneMint(height int64) error {
-	_, err := db.SQL.Exec(`DELETE FROM inflation WHERE height = $1`, height)
-	return fmt.Errorf("error while pruning inflation: %s", err)
-}
+// SavePrunedHeight stores the given height as the one below which the data of the given pruning group
+// has been pruned
+func (db *Db) SavePrunedHeight(group string, height int64) error {
+	stmt := `
+INSERT INTO pruning_group (group_name, pruned_height) 
+VALUES ($1, $2) 
+ON CONFLICT (group_name) DO UPDATE 
+    SET pruned_height = excluded.pruned_height
+WHERE pruning_group.pruned_height <= excluded.pruned_height`
 
-func (db *Db) pruneDistribution(height int64) error {
-	_, err := db.SQL.Exec(`DELETE FROM community_pool WHERE height = $1`, height)
+	_, err := db.SQL.Exec(stmt, group, height)
 	if err != nil {
-		return fmt.Errorf("error while pruning community pool: %s", err)
+		return fmt.Errorf("error while storing %s pruned height: %s", group, err)
 	}
 
 	return nil
 }
 
-func (db *Db) pruneSlashing(height int64) error {
-	_, err := db.SQL.Exec(`DELETE FROM validator_signing_info WHERE height = $1`, height)
+// GetFirstBlockHeightSince returns the height of the first block stored having a timestamp equal or after
+// the given one, or 0 if no such block is stored
+func (db *Db) GetFirstBlockHeightSince(timestamp time.Time) (int64, error) {
+	var heights []int64
+	stmt := `SELECT height FROM block WHERE timestamp >= $1 ORDER BY timestamp LIMIT 1`
+	err := db.Sqlx.Select(&heights, stmt, timestamp)
 	if err != nil {
-		return fmt.Errorf("error while pruning validator signing info: %s", err)
+		return 0, fmt.Errorf("error while getting first block height since %s: %s", timestamp, err)
 	}
 
-	_, err = db.SQL.Exec(`DELETE FROM slashing_params WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning slashing params: %s", err)
+	if len(heights) == 0 {
+		return 0, nil
 	}
 
-	return nil
+	return heights[0], nil
 }
@@ -0,0 +1,80 @@
+package database_test
+
+import (
+	"time"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_PruneGroup() {
+	for _, height := range []int64{10, 11, 12} {
+		_, err := suite.database.SQL.Exec(
+			`INSERT INTO supply_history (coins, height) VALUES ('{"(uatom,100)"}', $1)`, height)
+		suite.Require().NoError(err)
+
+		_, err = suite.database.SQL.Exec(`
+INSERT INTO inflation_history (height, inflation, annual_provisions, timestamp) 
+VALUES ($1, 0.1, 100, '2020-01-01')`, height)
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.database.SQL.Exec(`INSERT INTO supply (coins, height) VALUES ('{"(uatom,100)"}', 10)`)
+	suite.Require().NoError(err)
+
+	pruned, err := suite.database.PruneGroup(database.PruningGroupHistory, 10, 12)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), pruned["supply_history"])
+	suite.Require().Equal(int64(2), pruned["inflation_history"])
+	suite.Require().Equal(int64(0), pruned["validator_set_change"])
+
+	var heights []int64
+	err = suite.database.Sqlx.Select(&heights, `SELECT height FROM supply_history`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{12}, heights)
+
+	// The latest state should never be pruned
+	err = suite.database.Prune(10)
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM supply`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	_, err = suite.database.PruneGroup("blocks", 10, 12)
+	suite.Require().Error(err)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_PrunedHeight() {
+	height, err := suite.database.GetPrunedHeight(database.PruningGroupMessages)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+
+	err = suite.database.SavePrunedHeight(database.PruningGroupMessages, 100)
+	suite.Require().NoError(err)
+
+	// A lower height should not override the stored one
+	err = suite.database.SavePrunedHeight(database.PruningGroupMessages, 50)
+	suite.Require().NoError(err)
+
+	height, err = suite.database.GetPrunedHeight(database.PruningGroupMessages)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), height)
+
+	height, err = suite.database.GetPrunedHeight(database.PruningGroupHistory)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetFirstBlockHeightSince() {
+	block := suite.getBlock(10)
+	suite.getBlock(11)
+
+	height, err := suite.database.GetFirstBlockHeightSince(block.Timestamp.Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), height)
+
+	height, err = suite.database.GetFirstBlockHeightSince(block.Timestamp.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), height)
+}
@@ -0,0 +1,207 @@
+package database
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gopkg.in/yaml.v3"
+)
+
+// ReplicasConfig contains the configuration about the read replicas of the database
+type ReplicasConfig struct {
+	// URLs contains the connection URLs of the read replicas
+	URLs []string `yaml:"urls"`
+
+	// MaxLag represents the number of blocks a replica can be behind the primary database
+	// while still being used for the queries reading the latest data
+	MaxLag int64 `yaml:"max_lag"`
+
+	// SafetyMargin represents the number of blocks a replica must have stored after a given height before the
+	// data of that height is considered completely stored, since the transactions and the modules data of a
+	// block are stored after the block itself. It should not be lower than the number of parsing workers
+	SafetyMargin int64 `yaml:"safety_margin"`
+
+	// HeightCheckInterval represents how often the latest height stored inside each database is checked
+	HeightCheckInterval time.Duration `yaml:"height_check_interval"`
+}
+
+// NewReplicasConfig returns a new ReplicasConfig instance
+func NewReplicasConfig(
+	urls []string, maxLag int64, safetyMargin int64, heightCheckInterval time.Duration,
+) *ReplicasConfig {
+	return &ReplicasConfig{
+		URLs:                urls,
+		MaxLag:              maxLag,
+		SafetyMargin:        safetyMargin,
+		HeightCheckInterval: heightCheckInterval,
+	}
+}
+
+// DefaultReplicasConfig returns the default replicas configuration, which does not use any replica
+func DefaultReplicasConfig() *ReplicasConfig {
+	return NewReplicasConfig(nil, 0, 1, 5*time.Second)
+}
+
+// ParseReplicasConfig reads the read replicas configuration from the database section of the given configuration
+func ParseReplicasConfig(bz []byte) (*ReplicasConfig, error) {
+	type T struct {
+		Database struct {
+			Config *ReplicasConfig `yaml:"read_replicas"`
+		} `yaml:"database"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Database.Config == nil {
+		return DefaultReplicasConfig(), nil
+	}
+
+	// Use the default values for the missing fields
+	defaultCfg := DefaultReplicasConfig()
+	if cfg.Database.Config.MaxLag < 0 {
+		cfg.Database.Config.MaxLag = defaultCfg.MaxLag
+	}
+	if cfg.Database.Config.SafetyMargin <= 0 {
+		cfg.Database.Config.SafetyMargin = defaultCfg.SafetyMargin
+	}
+	if cfg.Database.Config.HeightCheckInterval <= 0 {
+		cfg.Database.Config.HeightCheckInterval = defaultCfg.HeightCheckInterval
+	}
+
+	return cfg.Database.Config, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// trackedDb represents a database connection along with the latest block height it has stored,
+// which is refreshed at most once per check interval
+type trackedDb struct {
+	Sqlx *sqlx.DB
+
+	mu        sync.Mutex
+	height    int64
+	checkedAt time.Time
+}
+
+// getHeight returns the latest block height stored inside the database. The cached height is refreshed
+// only if it has been checked more than the given interval ago, and it is lower than the given minimum height
+func (d *trackedDb) getHeight(minHeight int64, interval time.Duration) (int64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if (minHeight > 0 && d.height >= minHeight) || time.Since(d.checkedAt) < interval {
+		return d.height, nil
+	}
+
+	// Update the check time even on failures, so that an unreachable database is not queried every time
+	d.checkedAt = time.Now()
+
+	var height int64
+	err := d.Sqlx.QueryRow(`SELECT COALESCE(MAX(height), 0) FROM block`).Scan(&height)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	d.height = height
+	return d.height, nil
+}
+
+// readReplicas contains the read replicas that are used to perform the read-only queries
+type readReplicas struct {
+	cfg      *ReplicasConfig
+	primary  *trackedDb
+	replicas []*trackedDb
+
+	// next represents the index of the replica that should be tried first by the next query
+	next uint32
+}
+
+// SetReadReplicas connects to the read replicas having the given configuration, using the same connections limits
+// of the primary database. Once set, the read-only queries are performed using the replicas when possible
+func (db *Db) SetReadReplicas(cfg *ReplicasConfig, maxOpenConnections, maxIdleConnections int) error {
+	replicas := &readReplicas{
+		cfg:     cfg,
+		primary: &trackedDb{Sqlx: db.Sqlx},
+	}
+
+	for _, url := range cfg.URLs {
+		replicaDb, err := sqlx.Open("postgres", url)
+		if err != nil {
+			return fmt.Errorf("error while connecting to read replica: %s", err)
+		}
+
+		replicaDb.SetMaxOpenConns(maxOpenConnections)
+		replicaDb.SetMaxIdleConns(maxIdleConnections)
+		replicas.replicas = append(replicas.replicas, &trackedDb{Sqlx: replicaDb})
+	}
+
+	db.replicas = replicas
+	return nil
+}
+
+// Reader returns the connection that should be used to perform read-only queries requiring all the data stored
+// up to the given height. The first replica that has already stored the given height along with the configured
+// safety margin is returned, falling back to the primary database if no replica is set or all of them are behind
+func (db *Db) Reader(height int64) *sqlx.DB {
+	if db.replicas == nil || len(db.replicas.replicas) == 0 {
+		return db.Sqlx
+	}
+
+	return db.reader(height + db.replicas.cfg.SafetyMargin)
+}
+
+// reader returns the first replica that has stored all the blocks up to the given height,
+// or the primary database if all of them are behind
+func (db *Db) reader(height int64) *sqlx.DB {
+	interval := db.replicas.cfg.HeightCheckInterval
+	count := uint32(len(db.replicas.replicas))
+	first := atomic.AddUint32(&db.replicas.next, 1)
+
+	for i := uint32(0); i < count; i++ {
+		replica := db.replicas.replicas[(first+i)%count]
+		replicaHeight, err := replica.getHeight(height, interval)
+		if err == nil && replicaHeight >= height {
+			return replica.Sqlx
+		}
+	}
+
+	return db.Sqlx
+}
+
+// LatestReader returns the connection that should be used to perform read-only queries requiring the latest data.
+// A replica is used only if it is behind the primary database by no more than the configured max lag
+func (db *Db) LatestReader() *sqlx.DB {
+	if db.replicas == nil || len(db.replicas.replicas) == 0 {
+		return db.Sqlx
+	}
+
+	// The primary height is only refreshed once per interval, so that the primary is not queried every time
+	primaryHeight, err := db.replicas.primary.getHeight(0, db.replicas.cfg.HeightCheckInterval)
+	if err != nil {
+		return db.Sqlx
+	}
+
+	return db.reader(primaryHeight - db.replicas.cfg.MaxLag)
+}
+
+// Close implements database.Database, closing the read replicas connections as well
+func (db *Db) Close() {
+	db.Database.Close()
+
+	if db.replicas == nil {
+		return
+	}
+
+	for _, replica := range db.replicas.replicas {
+		err := replica.Sqlx.Close()
+		if err != nil {
+			db.Logger.Error("error while closing read replica connection", "err", err)
+		}
+	}
+}
@@ -28,6 +28,7 @@ CREATE TABLE block
 CREATE INDEX block_height_index ON block (height);
 CREATE INDEX block_hash_index ON block (hash);
 CREATE INDEX block_proposer_address_index ON block (proposer_address);
+CREATE INDEX block_timestamp_index ON block (timestamp);
 ALTER TABLE block
     SET (
         autovacuum_vacuum_scale_factor = 0,
@@ -68,14 +69,43 @@ CREATE INDEX transaction_partition_id_index ON transaction (partition_id);
 
 CREATE TABLE message_type
 (
-    type      TEXT   NOT NULL UNIQUE,
-    module    TEXT   NOT NULL,
-    label     TEXT   NOT NULL,
-    height    BIGINT NOT NULL
+    type             TEXT   NOT NULL UNIQUE,
+    module           TEXT   NOT NULL,
+    label            TEXT   NOT NULL,
+    height           BIGINT NOT NULL,
+    last_seen_height BIGINT NOT NULL DEFAULT 0
 );
 CREATE INDEX message_type_module_index ON message_type (module);
 CREATE INDEX message_type_type_index ON message_type (type);
 
+CREATE TABLE message_type_daily_stats
+(
+    type              TEXT   NOT NULL REFERENCES message_type (type),
+    date              DATE   NOT NULL,
+    messages_count    BIGINT NOT NULL DEFAULT 0,
+    senders_count     BIGINT NOT NULL DEFAULT 0,
+    first_seen_height BIGINT NOT NULL,
+    last_seen_height  BIGINT NOT NULL,
+    PRIMARY KEY (type, date)
+);
+CREATE INDEX message_type_daily_stats_date_index ON message_type_daily_stats (date);
+
+/* Senders of each message type per day, used to count the distinct senders */
+CREATE TABLE message_type_daily_sender
+(
+    type    TEXT NOT NULL,
+    date    DATE NOT NULL,
+    address TEXT NOT NULL,
+    PRIMARY KEY (type, date, address),
+    FOREIGN KEY (type, date) REFERENCES message_type_daily_stats (type, date)
+);
+
+/* Heights whose blocks are already included inside message_type_daily_stats */
+CREATE TABLE message_type_stats_block
+(
+    height BIGINT NOT NULL PRIMARY KEY
+);
+
 CREATE TABLE message
 (
     transaction_hash            TEXT   NOT NULL,
@@ -93,6 +123,7 @@ CREATE TABLE message
 CREATE INDEX message_transaction_hash_index ON message (transaction_hash);
 CREATE INDEX message_type_index ON message (type);
 CREATE INDEX message_involved_accounts_index ON message USING GIN(involved_accounts_addresses);
+CREATE INDEX message_height_index ON message (height);
 
 /**
  * This function is used to find all the utils that involve any of the given addresses and have
@@ -125,4 +156,11 @@ $$ LANGUAGE sql STABLE;
 CREATE TABLE pruning
 (
     last_pruned_height BIGINT NOT NULL
+);
+
+/* Height below which the data of each group of tables has been pruned according to its retention policy */
+CREATE TABLE pruning_group
+(
+    group_name    TEXT   NOT NULL PRIMARY KEY,
+    pruned_height BIGINT NOT NULL
 );
\ No newline at end of file
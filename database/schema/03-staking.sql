@@ -46,9 +46,11 @@ CREATE TABLE validator_description
     website           TEXT,
     security_contact  TEXT,
     details           TEXT,
-    height            BIGINT NOT NULL
+    height            BIGINT NOT NULL,
+    search_vector     TSVECTOR
 );
 CREATE INDEX validator_description_height_index ON validator_description (height);
+CREATE INDEX validator_description_search_vector_index ON validator_description USING GIN (search_vector);
 
 CREATE TABLE validator_commission
 (
@@ -76,6 +78,46 @@ CREATE TABLE validator_status
 );
 CREATE INDEX validator_status_height_index ON validator_status (height);
 
+/* ---- DECENTRALIZATION METRICS ---- */
+
+/*
+ * This table contains the metrics describing how the voting power is distributed among the active validators.
+ * The Nakamoto coefficients are the minimum number of validators holding more than 1/3 and 2/3 of the voting power,
+ * while the top shares are the fractions of the voting power held by the top 5, 10 and 20 validators.
+ */
+CREATE TABLE decentralization_metrics
+(
+    one_row_id              BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    validators_count        INTEGER NOT NULL,
+    total_voting_power      BIGINT  NOT NULL,
+    nakamoto_coefficient_33 INTEGER NOT NULL,
+    nakamoto_coefficient_66 INTEGER NOT NULL,
+    gini_coefficient        DECIMAL NOT NULL,
+    herfindahl_index        DECIMAL NOT NULL,
+    top_5_share             DECIMAL NOT NULL,
+    top_10_share            DECIMAL NOT NULL,
+    top_20_share            DECIMAL NOT NULL,
+    height                  BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX decentralization_metrics_height_index ON decentralization_metrics (height);
+
+CREATE TABLE decentralization_metrics_history
+(
+    validators_count        INTEGER                     NOT NULL,
+    total_voting_power      BIGINT                      NOT NULL,
+    nakamoto_coefficient_33 INTEGER                     NOT NULL,
+    nakamoto_coefficient_66 INTEGER                     NOT NULL,
+    gini_coefficient        DECIMAL                     NOT NULL,
+    herfindahl_index        DECIMAL                     NOT NULL,
+    top_5_share             DECIMAL                     NOT NULL,
+    top_10_share            DECIMAL                     NOT NULL,
+    top_20_share            DECIMAL                     NOT NULL,
+    height                  BIGINT                      NOT NULL PRIMARY KEY,
+    timestamp               TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX decentralization_metrics_history_timestamp_index ON decentralization_metrics_history (timestamp);
+
 /* ---- DOUBLE SIGN EVIDENCE ---- */
 
 /*
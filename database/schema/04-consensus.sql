@@ -7,38 +7,63 @@ CREATE TABLE genesis
     CHECK (one_row_id)
 );
 
-CREATE TABLE average_block_time_per_minute
+CREATE TABLE average_block_time_from_genesis
 (
     one_row_id   BOOL    NOT NULL DEFAULT TRUE PRIMARY KEY,
     average_time DECIMAL NOT NULL,
     height       BIGINT  NOT NULL,
     CHECK (one_row_id)
 );
-CREATE INDEX average_block_time_per_minute_height_index ON average_block_time_per_minute (height);
+CREATE INDEX average_block_time_from_genesis_height_index ON average_block_time_from_genesis (height);
 
-CREATE TABLE average_block_time_per_hour
+/* Block time statistics of the blocks produced inside each minute, hour or day */
+CREATE TABLE block_time_series
 (
-    one_row_id   BOOL    NOT NULL DEFAULT TRUE PRIMARY KEY,
-    average_time DECIMAL NOT NULL,
-    height       BIGINT  NOT NULL,
-    CHECK (one_row_id)
+    period             TEXT      NOT NULL,
+    bucket             TIMESTAMP NOT NULL,
+    blocks_count       BIGINT    NOT NULL,
+    min_block_time     DECIMAL   NOT NULL,
+    max_block_time     DECIMAL   NOT NULL,
+    average_block_time DECIMAL   NOT NULL,
+    p95_block_time     DECIMAL   NOT NULL,
+    first_height       BIGINT    NOT NULL,
+    last_height        BIGINT    NOT NULL,
+    PRIMARY KEY (period, bucket)
 );
-CREATE INDEX average_block_time_per_hour_height_index ON average_block_time_per_hour (height);
+CREATE INDEX block_time_series_bucket_index ON block_time_series (bucket);
 
-CREATE TABLE average_block_time_per_day
+CREATE TABLE proposer_daily_stats
 (
-    one_row_id   BOOL    NOT NULL DEFAULT TRUE PRIMARY KEY,
-    average_time DECIMAL NOT NULL,
-    height       BIGINT  NOT NULL,
-    CHECK (one_row_id)
+    validator_address  TEXT    NOT NULL REFERENCES validator (consensus_address),
+    date               DATE    NOT NULL,
+    blocks_proposed    BIGINT  NOT NULL DEFAULT 0,
+    expected_proposals DECIMAL NOT NULL DEFAULT 0,
+    empty_blocks       BIGINT  NOT NULL DEFAULT 0,
+    total_gas          BIGINT  NOT NULL DEFAULT 0,
+    average_gas        DECIMAL NOT NULL DEFAULT 0,
+    height             BIGINT  NOT NULL,
+    PRIMARY KEY (validator_address, date)
 );
-CREATE INDEX average_block_time_per_day_height_index ON average_block_time_per_day (height);
+CREATE INDEX proposer_daily_stats_date_index ON proposer_daily_stats (date);
 
-CREATE TABLE average_block_time_from_genesis
+/* Heights whose blocks are already included inside proposer_daily_stats */
+CREATE TABLE proposer_stats_block
 (
-    one_row_id   BOOL    NOT NULL DEFAULT TRUE PRIMARY KEY,
-    average_time DECIMAL NOT NULL,
-    height       BIGINT  NOT NULL,
-    CHECK (one_row_id)
+    height BIGINT NOT NULL PRIMARY KEY
 );
-CREATE INDEX average_block_time_from_genesis_height_index ON average_block_time_from_genesis (height);
+
+CREATE TABLE validator_set_change
+(
+    validator_address     TEXT      NOT NULL REFERENCES validator (consensus_address),
+    type                  TEXT      NOT NULL,
+    previous_voting_power BIGINT    NOT NULL,
+    voting_power          BIGINT    NOT NULL,
+    voting_power_delta    BIGINT    NOT NULL,
+    status                INT       NOT NULL,
+    jailed                BOOLEAN   NOT NULL,
+    height                BIGINT    NOT NULL,
+    timestamp             TIMESTAMP NOT NULL,
+    PRIMARY KEY (validator_address, height)
+);
+CREATE INDEX validator_set_change_height_index ON validator_set_change (height);
+CREATE INDEX validator_set_change_type_index ON validator_set_change (type);
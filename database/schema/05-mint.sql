@@ -17,4 +17,32 @@ CREATE TABLE inflation
     height     BIGINT  NOT NULL,
     CONSTRAINT one_row_uni CHECK (one_row_id)
 );
-CREATE INDEX inflation_height_index ON inflation (height);
\ No newline at end of file
+CREATE INDEX inflation_height_index ON inflation (height);
+
+CREATE TABLE inflation_history
+(
+    height            BIGINT                      NOT NULL PRIMARY KEY,
+    inflation         DECIMAL                     NOT NULL,
+    annual_provisions DECIMAL                     NOT NULL,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX inflation_history_timestamp_index ON inflation_history (timestamp);
+
+/* ---- SUPPLY PROJECTION ---- */
+
+/**
+ * This table contains the projected supply of the mint denom for each of the following days.
+ * The projection is computed from the current mint params, inflation, supply and bonded ratio, assuming that
+ * the bonded ratio does not change over time.
+ */
+CREATE TABLE supply_projection
+(
+    day               INTEGER                     NOT NULL PRIMARY KEY,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    projected_height  BIGINT                      NOT NULL,
+    inflation         DECIMAL                     NOT NULL,
+    annual_provisions DECIMAL                     NOT NULL,
+    supply            DECIMAL                     NOT NULL,
+    height            BIGINT                      NOT NULL
+);
+CREATE INDEX supply_projection_height_index ON supply_projection (height);
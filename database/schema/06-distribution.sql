@@ -26,3 +26,79 @@ CREATE TABLE community_pool
     CONSTRAINT one_row_uni CHECK (one_row_id)
 );
 CREATE INDEX community_pool_height_index ON community_pool (height);
+
+/* ---- REWARD WITHDRAWALS ---- */
+
+/*
+ * This table contains the rewards that have been withdrawn by delegators, either explicitly or automatically
+ * when changing their delegations. The validator_address column contains the validator operator address.
+ */
+CREATE TABLE delegation_reward_withdrawal
+(
+    tx_hash           TEXT                        NOT NULL,
+    msg_index         INTEGER                     NOT NULL,
+    delegator_address TEXT                        NOT NULL,
+    validator_address TEXT                        NOT NULL,
+    amount            COIN[]                      NOT NULL DEFAULT '{}',
+    height            BIGINT                      NOT NULL,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (tx_hash, msg_index, delegator_address, validator_address)
+);
+CREATE INDEX delegation_reward_withdrawal_delegator_address_index ON delegation_reward_withdrawal (delegator_address);
+CREATE INDEX delegation_reward_withdrawal_validator_address_index ON delegation_reward_withdrawal (validator_address);
+CREATE INDEX delegation_reward_withdrawal_timestamp_index ON delegation_reward_withdrawal (timestamp);
+
+/* ---- APR ---- */
+
+CREATE TABLE staking_apr
+(
+    one_row_id        BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    annual_provisions DECIMAL NOT NULL,
+    community_tax     DECIMAL NOT NULL,
+    bonded_tokens     DECIMAL NOT NULL,
+    apr               DECIMAL NOT NULL,
+    apy               DECIMAL NOT NULL,
+    height            BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX staking_apr_height_index ON staking_apr (height);
+
+CREATE TABLE staking_apr_history
+(
+    annual_provisions DECIMAL                     NOT NULL,
+    community_tax     DECIMAL                     NOT NULL,
+    bonded_tokens     DECIMAL                     NOT NULL,
+    apr               DECIMAL                     NOT NULL,
+    apy               DECIMAL                     NOT NULL,
+    height            BIGINT                      NOT NULL PRIMARY KEY,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL
+);
+CREATE INDEX staking_apr_history_timestamp_index ON staking_apr_history (timestamp);
+
+CREATE TABLE validator_apr
+(
+    validator_address TEXT    NOT NULL REFERENCES validator (consensus_address) PRIMARY KEY,
+    commission        DECIMAL NOT NULL,
+    apr               DECIMAL NOT NULL,
+    apy               DECIMAL NOT NULL,
+    realized_apr_7d   DECIMAL,
+    realized_apr_30d  DECIMAL,
+    realized_apr_90d  DECIMAL,
+    height            BIGINT  NOT NULL
+);
+CREATE INDEX validator_apr_height_index ON validator_apr (height);
+
+CREATE TABLE validator_apr_history
+(
+    validator_address TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    commission        DECIMAL                     NOT NULL,
+    apr               DECIMAL                     NOT NULL,
+    apy               DECIMAL                     NOT NULL,
+    realized_apr_7d   DECIMAL,
+    realized_apr_30d  DECIMAL,
+    realized_apr_90d  DECIMAL,
+    height            BIGINT                      NOT NULL,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (validator_address, height)
+);
+CREATE INDEX validator_apr_history_height_index ON validator_apr_history (height);
@@ -18,9 +18,11 @@ CREATE TABLE proposal
     voting_start_time TIMESTAMP,
     voting_end_time   TIMESTAMP,
     proposer_address  TEXT      NOT NULL REFERENCES account (address),
-    status            TEXT
+    status            TEXT,
+    search_vector     TSVECTOR
 );
 CREATE INDEX proposal_proposer_address_index ON proposal (proposer_address);
+CREATE INDEX proposal_search_vector_index ON proposal USING GIN (search_vector);
 
 CREATE TABLE proposal_deposit
 (
@@ -73,6 +75,26 @@ CREATE TABLE proposal_staking_pool_snapshot
 );
 CREATE INDEX proposal_staking_pool_snapshot_proposal_id_index ON proposal_staking_pool_snapshot (proposal_id);
 
+/*
+ * This holds the outcome each proposal would have if its voting period ended at the given height.
+ * It is computed combining the latest tally result, the staking pool snapshot and the gov params,
+ * and it is refreshed every time the tally result of a proposal is updated.
+ */
+CREATE TABLE proposal_tally_projection
+(
+    proposal_id      INTEGER REFERENCES proposal (id) PRIMARY KEY,
+    turnout          DECIMAL NOT NULL,
+    quorum           DECIMAL NOT NULL,
+    quorum_reached   BOOLEAN NOT NULL,
+    yes_ratio        DECIMAL NOT NULL,
+    threshold        DECIMAL NOT NULL,
+    veto_ratio       DECIMAL NOT NULL,
+    veto_threshold   DECIMAL NOT NULL,
+    projected_status TEXT    NOT NULL,
+    height           BIGINT  NOT NULL
+);
+CREATE INDEX proposal_tally_projection_height_index ON proposal_tally_projection (height);
+
 CREATE TABLE proposal_validator_status_snapshot
 (
     id                SERIAL  PRIMARY KEY NOT NULL,
@@ -1,4 +1,17 @@
 CREATE TABLE modules
 (
     module_name TEXT NOT NULL UNIQUE PRIMARY KEY
-);
\ No newline at end of file
+);
+
+/**
+ * This table keeps track of every change of the parameters of each module.
+ * A new row is only stored when the parameters differ from the ones that were in place before.
+ */
+CREATE TABLE params_history
+(
+    module_name TEXT   NOT NULL,
+    params      JSONB  NOT NULL,
+    height      BIGINT NOT NULL,
+    PRIMARY KEY (module_name, height)
+);
+CREATE INDEX params_history_height_index ON params_history (height);
@@ -1,10 +1,43 @@
+/* Fee grant allowances are never deleted, revoked or expired ones are kept as history using their status */
 CREATE TABLE fee_grant_allowance
 (
-    id                 SERIAL      NOT NULL PRIMARY KEY,
-    grantee_address    TEXT        NOT NULL REFERENCES account (address),
-    granter_address    TEXT        NOT NULL REFERENCES account (address),
-    allowance          JSONB       NOT NULL DEFAULT '{}'::JSONB,
-    height             BIGINT      NOT NULL,
-    CONSTRAINT unique_fee_grant_allowance UNIQUE(grantee_address, granter_address) 
+    id                  SERIAL    NOT NULL PRIMARY KEY,
+    grantee_address     TEXT      NOT NULL REFERENCES account (address),
+    granter_address     TEXT      NOT NULL REFERENCES account (address),
+    allowance           JSONB     NOT NULL DEFAULT '{}'::JSONB,
+
+    /* State of the allowance after the latest fee payment */
+    remaining_allowance JSONB     NOT NULL DEFAULT '{}'::JSONB,
+    spend_limit         COIN[],
+    period_spend_limit  COIN[],
+    period_can_spend    COIN[],
+    period_reset        TIMESTAMP,
+    expiration          TIMESTAMP,
+    allowed_messages    TEXT[],
+    spent               COIN[]    NOT NULL DEFAULT '{}',
+    last_used_height    BIGINT,
+
+    status              TEXT      NOT NULL DEFAULT 'active',
+    height              BIGINT    NOT NULL,
+    end_height          BIGINT,
+    CONSTRAINT unique_fee_grant_allowance UNIQUE (grantee_address, granter_address, height)
 );
 CREATE INDEX fee_grant_allowance_height_index ON fee_grant_allowance (height);
+CREATE INDEX fee_grant_allowance_status_index ON fee_grant_allowance (status);
+CREATE INDEX fee_grant_allowance_expiration_index ON fee_grant_allowance (expiration);
+
+/* Fees paid by granters on behalf of grantees */
+CREATE TABLE fee_grant_usage
+(
+    transaction_hash TEXT      NOT NULL PRIMARY KEY,
+    allowance_id     INT REFERENCES fee_grant_allowance (id),
+    grantee_address  TEXT      NOT NULL REFERENCES account (address),
+    granter_address  TEXT      NOT NULL REFERENCES account (address),
+    fee              COIN[]    NOT NULL DEFAULT '{}',
+    height           BIGINT    NOT NULL,
+    timestamp        TIMESTAMP NOT NULL
+);
+CREATE INDEX fee_grant_usage_allowance_id_index ON fee_grant_usage (allowance_id);
+CREATE INDEX fee_grant_usage_grantee_address_index ON fee_grant_usage (grantee_address);
+CREATE INDEX fee_grant_usage_granter_address_index ON fee_grant_usage (granter_address);
+CREATE INDEX fee_grant_usage_height_index ON fee_grant_usage (height);
@@ -8,3 +8,23 @@ CREATE TABLE software_upgrade_plan
 );
 CREATE INDEX software_upgrade_plan_proposal_id_index ON software_upgrade_plan (proposal_id);
 CREATE INDEX software_upgrade_plan_height_index ON software_upgrade_plan (height);
+
+/**
+ * This table keeps track of every software upgrade plan that has ever been scheduled, along with its outcome.
+ * The status can be one of scheduled, cancelled or applied.
+ */
+CREATE TABLE upgrade_history
+(
+    proposal_id        INTEGER NOT NULL REFERENCES proposal (id) PRIMARY KEY,
+    plan_name          TEXT    NOT NULL,
+    upgrade_height     BIGINT  NOT NULL,
+    info               TEXT    NOT NULL,
+    binary_info        JSONB,
+    status             TEXT    NOT NULL,
+    cancel_proposal_id INTEGER REFERENCES proposal (id),
+    applied_height     BIGINT,
+    applied_time       TIMESTAMP WITHOUT TIME ZONE,
+    height             BIGINT  NOT NULL
+);
+CREATE INDEX upgrade_history_upgrade_height_index ON upgrade_history (upgrade_height);
+CREATE INDEX upgrade_history_status_index ON upgrade_history (status);
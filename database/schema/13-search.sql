@@ -0,0 +1,73 @@
+/**
+ * This table is never written to. It only defines the type of the rows returned by the search function,
+ * so that the function can be tracked by Hasura.
+ * The type column tells which kind of entity has been found (block, transaction, validator, proposal or account),
+ * while the value column contains the identifier that should be used to reference it.
+ */
+CREATE TABLE search_result
+(
+    type  TEXT NOT NULL,
+    value TEXT NOT NULL,
+    label TEXT,
+    rank  REAL NOT NULL
+);
+
+CREATE INDEX account_address_pattern_index ON account (address text_pattern_ops);
+
+/**
+ * This function is used to resolve a single search query into all the entities it might refer to.
+ * Blocks are matched by height or hash, transactions by hash, validators by address or description,
+ * proposals by id or title and description, and accounts by address prefix.
+ */
+CREATE FUNCTION search(
+    query TEXT,
+    "limit" BIGINT = 20)
+    RETURNS SETOF search_result AS
+$$
+SELECT * FROM (
+    SELECT 'block'::TEXT AS type, height::TEXT AS value, hash AS label, 1::REAL AS rank
+    FROM block
+    WHERE height = substring(trim(query) FROM '^[0-9]{1,18}$')::BIGINT
+       OR hash = upper(trim(query))
+
+    UNION ALL
+
+    SELECT 'transaction'::TEXT, hash, height::TEXT, 1::REAL
+    FROM transaction
+    WHERE hash = upper(trim(query))
+
+    UNION ALL
+
+    SELECT 'validator'::TEXT, validator_info.operator_address, validator_description.moniker,
+           CASE WHEN trim(query) IN (validator_info.consensus_address, validator_info.operator_address,
+                                     validator_info.self_delegate_address)
+                THEN 1::REAL
+                ELSE ts_rank(validator_description.search_vector, plainto_tsquery('simple', query))
+           END
+    FROM validator_info
+    LEFT JOIN validator_description ON validator_description.validator_address = validator_info.consensus_address
+    WHERE trim(query) IN (validator_info.consensus_address, validator_info.operator_address,
+                          validator_info.self_delegate_address)
+       OR validator_description.search_vector @@ plainto_tsquery('simple', query)
+
+    UNION ALL
+
+    SELECT 'proposal'::TEXT, id::TEXT, title,
+           CASE WHEN id = substring(trim(query) FROM '^[0-9]{1,9}$')::INTEGER
+                THEN 1::REAL
+                ELSE ts_rank(search_vector, plainto_tsquery('simple', query))
+           END
+    FROM proposal
+    WHERE id = substring(trim(query) FROM '^[0-9]{1,9}$')::INTEGER
+       OR search_vector @@ plainto_tsquery('simple', query)
+
+    UNION ALL
+
+    SELECT 'account'::TEXT, address, NULL::TEXT,
+           CASE WHEN address = trim(query) THEN 1::REAL ELSE 0::REAL END
+    FROM account
+    WHERE trim(query) <> ''
+      AND address LIKE replace(replace(replace(trim(query), '\', '\\'), '%', '\%'), '_', '\_') || '%'
+) AS result
+ORDER BY rank DESC, type, value LIMIT "limit"
+$$ LANGUAGE sql STABLE;
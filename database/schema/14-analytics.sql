@@ -0,0 +1,87 @@
+CREATE TABLE chain_activity
+(
+    period         TEXT      NOT NULL,
+    bucket         TIMESTAMP NOT NULL,
+    txs_count      BIGINT    NOT NULL DEFAULT 0,
+    successful_txs BIGINT    NOT NULL DEFAULT 0,
+    failed_txs     BIGINT    NOT NULL DEFAULT 0,
+    active_signers BIGINT    NOT NULL DEFAULT 0,
+    new_accounts   BIGINT    NOT NULL DEFAULT 0,
+    gas_used       BIGINT    NOT NULL DEFAULT 0,
+    gas_wanted     BIGINT    NOT NULL DEFAULT 0,
+    height         BIGINT    NOT NULL,
+    PRIMARY KEY (period, bucket)
+);
+CREATE INDEX chain_activity_bucket_index ON chain_activity (bucket);
+
+CREATE TABLE chain_activity_fee
+(
+    period TEXT      NOT NULL,
+    bucket TIMESTAMP NOT NULL,
+    denom  TEXT      NOT NULL,
+    amount NUMERIC   NOT NULL,
+    PRIMARY KEY (period, bucket, denom),
+    FOREIGN KEY (period, bucket) REFERENCES chain_activity (period, bucket)
+);
+
+/* Signers of each bucket, used to count the unique active signers */
+CREATE TABLE chain_activity_signer
+(
+    period  TEXT      NOT NULL,
+    bucket  TIMESTAMP NOT NULL,
+    address TEXT      NOT NULL,
+    PRIMARY KEY (period, bucket, address),
+    FOREIGN KEY (period, bucket) REFERENCES chain_activity (period, bucket)
+);
+
+/* Heights whose blocks are already included inside chain_activity */
+CREATE TABLE chain_activity_block
+(
+    height BIGINT NOT NULL PRIMARY KEY
+);
+
+CREATE TABLE fee_market_block
+(
+    height         BIGINT    NOT NULL PRIMARY KEY REFERENCES block (height),
+    txs_count      BIGINT    NOT NULL,
+    gas_used       BIGINT    NOT NULL,
+    gas_wanted     BIGINT    NOT NULL,
+    gas_efficiency DECIMAL   NOT NULL,
+    out_of_gas_txs BIGINT    NOT NULL,
+    timestamp      TIMESTAMP NOT NULL
+);
+CREATE INDEX fee_market_block_timestamp_index ON fee_market_block (timestamp);
+
+/* Effective gas prices (fee amount / gas wanted) paid inside each block, per fee denom */
+CREATE TABLE gas_price_block
+(
+    height           BIGINT  NOT NULL REFERENCES block (height),
+    denom            TEXT    NOT NULL,
+    txs_count        BIGINT  NOT NULL,
+    min_gas_price    DECIMAL NOT NULL,
+    median_gas_price DECIMAL NOT NULL,
+    p90_gas_price    DECIMAL NOT NULL,
+    PRIMARY KEY (height, denom)
+);
+
+CREATE TABLE fee_market_hourly
+(
+    bucket         TIMESTAMP NOT NULL PRIMARY KEY,
+    txs_count      BIGINT    NOT NULL,
+    gas_used       BIGINT    NOT NULL,
+    gas_wanted     BIGINT    NOT NULL,
+    gas_efficiency DECIMAL   NOT NULL,
+    out_of_gas_txs BIGINT    NOT NULL
+);
+
+CREATE TABLE gas_price_hourly
+(
+    bucket           TIMESTAMP NOT NULL,
+    denom            TEXT      NOT NULL,
+    txs_count        BIGINT    NOT NULL,
+    min_gas_price    DECIMAL   NOT NULL,
+    median_gas_price DECIMAL   NOT NULL,
+    p90_gas_price    DECIMAL   NOT NULL,
+    PRIMARY KEY (bucket, denom)
+);
+CREATE INDEX gas_price_hourly_denom_index ON gas_price_hourly (denom);
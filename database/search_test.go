@@ -0,0 +1,86 @@
+package database_test
+
+import (
+	"strings"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// searchResultRow represents a single row returned by the search function
+type searchResultRow struct {
+	Type  string  `db:"type"`
+	Value string  `db:"value"`
+	Label *string `db:"label"`
+	Rank  float64 `db:"rank"`
+}
+
+func (suite *DbTestSuite) search(query string) []searchResultRow {
+	var rows []searchResultRow
+	err := suite.database.Sqlx.Select(&rows, `SELECT * FROM search($1)`, query)
+	suite.Require().NoError(err)
+	return rows
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_Search() {
+	block := suite.getBlock(100)
+	proposal := suite.getProposalRow(5)
+
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+	err := suite.database.SaveValidatorDescription(types.NewValidatorDescription(
+		validator.GetOperator(),
+		stakingtypes.NewDescription("Forbole", "identity", "", "", "Validator details"),
+		"",
+		10,
+	))
+	suite.Require().NoError(err)
+
+	// Search a block by height
+	rows := suite.search("100")
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("block", rows[0].Type)
+	suite.Require().Equal("100", rows[0].Value)
+	suite.Require().Equal(block.Hash, *rows[0].Label)
+
+	// Search a block by hash, ignoring the case
+	rows = suite.search(strings.ToLower(block.Hash))
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("block", rows[0].Type)
+	suite.Require().Equal("100", rows[0].Value)
+
+	// Search a proposal by id and by title
+	rows = suite.search("5")
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("proposal", rows[0].Type)
+	suite.Require().Equal("5", rows[0].Value)
+
+	rows = suite.search(proposal.Title)
+	suite.Require().NotEmpty(rows)
+	suite.Require().Equal("proposal", rows[0].Type)
+	suite.Require().Equal("5", rows[0].Value)
+
+	// Search a validator by moniker and by address
+	rows = suite.search("forbole")
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("validator", rows[0].Type)
+	suite.Require().Equal(validator.GetOperator(), rows[0].Value)
+
+	rows = suite.search(validator.GetConsAddr())
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("validator", rows[0].Type)
+
+	// Search an account by address fragment
+	rows = suite.search("cosmos1z4hfrxvl")
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("account", rows[0].Type)
+	suite.Require().Equal("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", rows[0].Value)
+
+	// Search something that does not exist
+	rows = suite.search("non existing")
+	suite.Require().Empty(rows)
+}
@@ -1,9 +1,12 @@
 package database
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -13,25 +16,13 @@ func (db *Db) SaveValidatorsSigningInfos(infos []types.ValidatorSigningInfo) err
 		return nil
 	}
 
-	stmt := `
-INSERT INTO validator_signing_info 
-    (validator_address, start_height, index_offset, jailed_until, tombstoned, missed_blocks_counter, height)
-VALUES `
-	var args []interface{}
-
-	for i, info := range infos {
-		ii := i * 7
-
-		stmt += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d),", ii+1, ii+2, ii+3, ii+4, ii+5, ii+6, ii+7)
-		args = append(args,
-			info.ValidatorAddress, info.StartHeight, info.IndexOffset, info.JailedUntil, info.Tombstoned,
-			info.MissedBlocksCounter, info.Height,
-		)
-	}
-
-	stmt = stmt[:len(stmt)-1] // Remove trailing ","
-	stmt += `
-ON CONFLICT (validator_address) DO UPDATE 
+	insert := newBulkInsert(
+		"validator_signing_info",
+		[]string{
+			"validator_address", "start_height", "index_offset", "jailed_until", "tombstoned",
+			"missed_blocks_counter", "height",
+		},
+		`ON CONFLICT (validator_address) DO UPDATE 
 	SET validator_address = excluded.validator_address,
 		start_height = excluded.start_height,
 		index_offset = excluded.index_offset,
@@ -39,14 +30,23 @@ ON CONFLICT (validator_address) DO UPDATE
 		tombstoned = excluded.tombstoned,
 		missed_blocks_counter = excluded.missed_blocks_counter,
 		height = excluded.height
-WHERE validator_signing_info.height <= excluded.height`
+WHERE validator_signing_info.height <= excluded.height`,
+	)
 
-	_, err := db.SQL.Exec(stmt, args...)
-	if err != nil {
-		return fmt.Errorf("error while storing validators signing infos: %s", err)
+	for _, info := range infos {
+		insert.add(
+			info.ValidatorAddress, info.StartHeight, info.IndexOffset, info.JailedUntil, info.Tombstoned,
+			info.MissedBlocksCounter, info.Height,
+		)
 	}
 
-	return nil
+	return db.execBlockOperation(infos[0].Height, func(tx *sql.Tx) error {
+		err := insert.exec(tx)
+		if err != nil {
+			return fmt.Errorf("error while storing validators signing infos: %s", err)
+		}
+		return nil
+	})
 }
 
 // SaveSlashingParams saves the slashing params for the given height
@@ -64,10 +64,11 @@ ON CONFLICT (one_row_id) DO UPDATE
         height = excluded.height
 WHERE slashing_params.height <= excluded.height`
 
-	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
-	if err != nil {
-		return fmt.Errorf("error while storing slashing params: %s", err)
-	}
-
-	return nil
+	return db.execBlockOperation(params.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, string(paramsBz), params.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing slashing params: %s", err)
+		}
+		return saveParamsHistory(tx, slashingtypes.ModuleName, paramsBz, params.Height)
+	})
 }
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveDecentralizationMetrics allows to store the given decentralization metrics,
+// both as the current values and inside their history
+func (db *Db) SaveDecentralizationMetrics(metrics types.DecentralizationMetrics) error {
+	stmt := `
+INSERT INTO decentralization_metrics (
+    validators_count, total_voting_power, nakamoto_coefficient_33, nakamoto_coefficient_66, 
+    gini_coefficient, herfindahl_index, top_5_share, top_10_share, top_20_share, height
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET validators_count = excluded.validators_count,
+        total_voting_power = excluded.total_voting_power,
+        nakamoto_coefficient_33 = excluded.nakamoto_coefficient_33,
+        nakamoto_coefficient_66 = excluded.nakamoto_coefficient_66,
+        gini_coefficient = excluded.gini_coefficient,
+        herfindahl_index = excluded.herfindahl_index,
+        top_5_share = excluded.top_5_share,
+        top_10_share = excluded.top_10_share,
+        top_20_share = excluded.top_20_share,
+        height = excluded.height
+WHERE decentralization_metrics.height <= excluded.height`
+
+	params := []interface{}{
+		metrics.ValidatorsCount,
+		metrics.TotalVotingPower,
+		metrics.NakamotoCoefficient33,
+		metrics.NakamotoCoefficient66,
+		metrics.GiniCoefficient.String(),
+		metrics.HerfindahlIndex.String(),
+		metrics.Top5Share.String(),
+		metrics.Top10Share.String(),
+		metrics.Top20Share.String(),
+		metrics.Height,
+	}
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing decentralization metrics: %s", err)
+	}
+
+	stmt = `
+INSERT INTO decentralization_metrics_history (
+    validators_count, total_voting_power, nakamoto_coefficient_33, nakamoto_coefficient_66, 
+    gini_coefficient, herfindahl_index, top_5_share, top_10_share, top_20_share, height, timestamp
+) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (height) DO UPDATE 
+    SET validators_count = excluded.validators_count,
+        total_voting_power = excluded.total_voting_power,
+        nakamoto_coefficient_33 = excluded.nakamoto_coefficient_33,
+        nakamoto_coefficient_66 = excluded.nakamoto_coefficient_66,
+        gini_coefficient = excluded.gini_coefficient,
+        herfindahl_index = excluded.herfindahl_index,
+        top_5_share = excluded.top_5_share,
+        top_10_share = excluded.top_10_share,
+        top_20_share = excluded.top_20_share,
+        timestamp = excluded.timestamp`
+
+	_, err = db.SQL.Exec(stmt, append(params, metrics.Timestamp)...)
+	if err != nil {
+		return fmt.Errorf("error while storing decentralization metrics history: %s", err)
+	}
+
+	return nil
+}
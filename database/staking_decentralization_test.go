@@ -0,0 +1,49 @@
+package database_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDecentralizationMetrics() {
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newMetrics := func(nakamoto int, height int64) types.DecentralizationMetrics {
+		return types.NewDecentralizationMetrics(
+			4, 40, nakamoto, 3,
+			sdk.ZeroDec(), sdk.NewDecWithPrec(25, 2), sdk.OneDec(), sdk.OneDec(), sdk.OneDec(),
+			height, timestamp,
+		)
+	}
+
+	err := suite.database.SaveDecentralizationMetrics(newMetrics(2, 10))
+	suite.Require().NoError(err)
+
+	// Try updating with a lower height
+	err = suite.database.SaveDecentralizationMetrics(newMetrics(1, 9))
+	suite.Require().NoError(err)
+
+	var nakamoto []int
+	err = suite.database.Sqlx.Select(&nakamoto, `SELECT nakamoto_coefficient_33 FROM decentralization_metrics`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int{2}, nakamoto)
+
+	// Try updating with a higher height
+	err = suite.database.SaveDecentralizationMetrics(newMetrics(3, 20))
+	suite.Require().NoError(err)
+
+	nakamoto = nil
+	err = suite.database.Sqlx.Select(&nakamoto, `SELECT nakamoto_coefficient_33 FROM decentralization_metrics`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int{3}, nakamoto)
+
+	// Verify the history
+	nakamoto = nil
+	err = suite.database.Sqlx.Select(&nakamoto,
+		`SELECT nakamoto_coefficient_33 FROM decentralization_metrics_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int{1, 2, 3}, nakamoto)
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 
@@ -25,12 +26,13 @@ ON CONFLICT (one_row_id) DO UPDATE
         height = excluded.height
 WHERE staking_params.height <= excluded.height`
 
-	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
-	if err != nil {
-		return fmt.Errorf("error while storing staking params: %s", err)
-	}
-
-	return nil
+	return db.execBlockOperation(params.Height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt, string(paramsBz), params.Height)
+		if err != nil {
+			return fmt.Errorf("error while storing staking params: %s", err)
+		}
+		return saveParamsHistory(tx, stakingtypes.ModuleName, paramsBz, params.Height)
+	})
 }
 
 // GetStakingParams returns the types.StakingParams instance containing the current params
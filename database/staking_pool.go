@@ -1,8 +1,12 @@
 package database
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -31,3 +35,32 @@ WHERE staking_pool.height <= excluded.height`
 
 	return nil
 }
+
+// GetStakingPool returns the most recent staking pool stored inside the database.
+// If no staking pool has been stored yet, returns nil instead
+func (db *Db) GetStakingPool() (*types.Pool, error) {
+	stmt := `
+SELECT bonded_tokens, not_bonded_tokens, unbonding_tokens, staked_not_bonded_tokens, height 
+FROM staking_pool`
+
+	var bonded, notBonded, unbonding, stakedNotBonded string
+	var height int64
+	err := db.SQL.QueryRow(stmt).Scan(&bonded, &notBonded, &unbonding, &stakedNotBonded, &height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking pool: %s", err)
+	}
+
+	var amounts []sdkmath.Int
+	for _, value := range []string{bonded, notBonded, unbonding, stakedNotBonded} {
+		amount, ok := sdkmath.NewIntFromString(value)
+		if !ok {
+			return nil, fmt.Errorf("invalid staking pool amount: %s", value)
+		}
+		amounts = append(amounts, amount)
+	}
+
+	return types.NewPool(amounts[0], amounts[1], amounts[2], amounts[3], height), nil
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
 
 	"github.com/forbole/callisto/v4/types"
@@ -234,9 +235,12 @@ func (db *Db) SaveValidatorDescription(description types.ValidatorDescription) e
 	// Insert the description
 	stmt := `
 INSERT INTO validator_description (
-	validator_address, moniker, identity, avatar_url, website, security_contact, details, height
+	validator_address, moniker, identity, avatar_url, website, security_contact, details, height, search_vector
 )
-VALUES($1, $2, $3, $4, $5, $6, $7, $8)
+VALUES($1, $2, $3, $4, $5, $6, $7, $8,
+	setweight(to_tsvector('simple', coalesce($2::TEXT, '')), 'A') ||
+	setweight(to_tsvector('simple', coalesce($3::TEXT, '')), 'B') ||
+	setweight(to_tsvector('simple', coalesce($7::TEXT, '')), 'C'))
 ON CONFLICT (validator_address) DO UPDATE
     SET moniker = excluded.moniker, 
         identity = excluded.identity, 
@@ -244,7 +248,8 @@ ON CONFLICT (validator_address) DO UPDATE
         website = excluded.website, 
         security_contact = excluded.security_contact, 
         details = excluded.details,
-        height = excluded.height
+        height = excluded.height,
+        search_vector = excluded.search_vector
 WHERE validator_description.height <= excluded.height`
 
 	_, err = db.SQL.Exec(stmt,
@@ -372,28 +377,50 @@ func (db *Db) SaveValidatorsVotingPowers(entries []types.ValidatorVotingPower) e
 		return nil
 	}
 
-	stmt := `INSERT INTO validator_voting_power (validator_address, voting_power, height) VALUES `
-	var params []interface{}
+	insert := newBulkInsert(
+		"validator_voting_power",
+		[]string{"validator_address", "voting_power", "height"},
+		`ON CONFLICT (validator_address) DO UPDATE 
+	SET voting_power = excluded.voting_power, 
+		height = excluded.height
+WHERE validator_voting_power.height <= excluded.height`,
+	)
 
-	for i, entry := range entries {
-		pi := i * 3
-		stmt += fmt.Sprintf("($%d,$%d,$%d),", pi+1, pi+2, pi+3)
-		params = append(params, entry.ConsensusAddress, entry.VotingPower, entry.Height)
+	for _, entry := range entries {
+		insert.add(entry.ConsensusAddress, entry.VotingPower, entry.Height)
 	}
 
-	stmt = stmt[:len(stmt)-1]
-	stmt += `
-ON CONFLICT (validator_address) DO UPDATE 
-	SET voting_power = excluded.voting_power, 
-		height = excluded.height
-WHERE validator_voting_power.height <= excluded.height`
+	return db.execBlockOperation(entries[0].Height, func(tx *sql.Tx) error {
+		err := insert.exec(tx)
+		if err != nil {
+			return fmt.Errorf("error while storing validators voting power: %s", err)
+		}
+		return nil
+	})
+}
 
-	_, err := db.SQL.Exec(stmt, params...)
+// GetValidatorsStakingData returns the latest commission, voting power and status of all the validators
+func (db *Db) GetValidatorsStakingData() ([]dbtypes.ValidatorStakingDataRow, error) {
+	stmt := `
+SELECT validator_info.consensus_address,
+       validator_info.operator_address,
+       validator_commission.commission::TEXT AS commission,
+       coalesce(validator_voting_power.voting_power, 0) AS voting_power,
+       coalesce(validator_status.status, 0) AS status,
+       coalesce(validator_status.jailed, false) AS jailed
+FROM validator_info
+LEFT JOIN validator_commission ON validator_commission.validator_address = validator_info.consensus_address
+LEFT JOIN validator_voting_power ON validator_voting_power.validator_address = validator_info.consensus_address
+LEFT JOIN validator_status ON validator_status.validator_address = validator_info.consensus_address
+ORDER BY validator_info.consensus_address`
+
+	var rows []dbtypes.ValidatorStakingDataRow
+	err := db.Sqlx.Select(&rows, stmt)
 	if err != nil {
-		return fmt.Errorf("error while storing validators voting power: %s", err)
+		return nil, fmt.Errorf("error while getting validators staking data: %s", err)
 	}
 
-	return nil
+	return rows, nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
@@ -404,42 +431,40 @@ func (db *Db) SaveValidatorsStatuses(statuses []types.ValidatorStatus) error {
 		return nil
 	}
 
-	validatorStmt := `INSERT INTO validator (consensus_address, consensus_pubkey) VALUES`
-	var valParams []interface{}
-
-	statusStmt := `INSERT INTO validator_status (validator_address, status, jailed, height) VALUES `
-	var statusParams []interface{}
-
-	for i, status := range statuses {
-		vi := i * 2
-		validatorStmt += fmt.Sprintf("($%d, $%d),", vi+1, vi+2)
-		valParams = append(valParams, status.ConsensusAddress, status.ConsensusPubKey)
-
-		si := i * 4
-		statusStmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", si+1, si+2, si+3, si+4)
-		statusParams = append(statusParams, status.ConsensusAddress, status.Status, status.Jailed, status.Height)
-	}
-
-	validatorStmt = validatorStmt[:len(validatorStmt)-1]
-	validatorStmt += "ON CONFLICT DO NOTHING"
-	_, err := db.SQL.Exec(validatorStmt, valParams...)
-	if err != nil {
-		return fmt.Errorf("error while storing validators: %s", err)
-	}
+	validatorsInsert := newBulkInsert(
+		"validator",
+		[]string{"consensus_address", "consensus_pubkey"},
+		`ON CONFLICT DO NOTHING`,
+	)
 
-	statusStmt = statusStmt[:len(statusStmt)-1]
-	statusStmt += `
-ON CONFLICT (validator_address) DO UPDATE 
+	statusesInsert := newBulkInsert(
+		"validator_status",
+		[]string{"validator_address", "status", "jailed", "height"},
+		`ON CONFLICT (validator_address) DO UPDATE 
 	SET status = excluded.status,
 	    jailed = excluded.jailed,
 	    height = excluded.height
-WHERE validator_status.height <= excluded.height`
-	_, err = db.SQL.Exec(statusStmt, statusParams...)
-	if err != nil {
-		return fmt.Errorf("error while storing validators statuses: %s", err)
+WHERE validator_status.height <= excluded.height`,
+	)
+
+	for _, status := range statuses {
+		validatorsInsert.add(status.ConsensusAddress, status.ConsensusPubKey)
+		statusesInsert.add(status.ConsensusAddress, status.Status, status.Jailed, status.Height)
 	}
 
-	return nil
+	return db.execBlockOperation(statuses[0].Height, func(tx *sql.Tx) error {
+		err := validatorsInsert.exec(tx)
+		if err != nil {
+			return fmt.Errorf("error while storing validators: %s", err)
+		}
+
+		err = statusesInsert.exec(tx)
+		if err != nil {
+			return fmt.Errorf("error while storing validators statuses: %s", err)
+		}
+
+		return nil
+	})
 }
 
 // saveDoubleSignVote saves the given vote inside the database, returning the row id
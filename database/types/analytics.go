@@ -0,0 +1,28 @@
+package types
+
+import "time"
+
+// GasPriceRow represents a single row of the gas_price_hourly table
+type GasPriceRow struct {
+	Denom          string `db:"denom"`
+	TxsCount       int64  `db:"txs_count"`
+	MinGasPrice    string `db:"min_gas_price"`
+	MedianGasPrice string `db:"median_gas_price"`
+	P90GasPrice    string `db:"p90_gas_price"`
+}
+
+// GasPriceHourlyRow represents a single row of the gas_price_hourly table along with its bucket
+type GasPriceHourlyRow struct {
+	Bucket time.Time `db:"bucket"`
+	GasPriceRow
+}
+
+// FeeMarketHourlyRow represents a single row of the fee_market_hourly table
+type FeeMarketHourlyRow struct {
+	Bucket        time.Time `db:"bucket"`
+	TxsCount      int64     `db:"txs_count"`
+	GasUsed       int64     `db:"gas_used"`
+	GasWanted     int64     `db:"gas_wanted"`
+	GasEfficiency string    `db:"gas_efficiency"`
+	OutOfGasTxs   int64     `db:"out_of_gas_txs"`
+}
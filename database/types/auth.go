@@ -1,5 +1,10 @@
 package types
 
+import (
+	"database/sql"
+	"time"
+)
+
 // AccountRow represents a single row inside the account table
 type AccountRow struct {
 	Address string `db:"address"`
@@ -16,3 +21,21 @@ func NewAccountRow(address string) AccountRow {
 func (a AccountRow) Equal(b AccountRow) bool {
 	return a.Address == b.Address
 }
+
+// VestingAccountRow represents a single row inside the vesting_account table
+type VestingAccountRow struct {
+	ID              int64        `db:"id"`
+	Type            string       `db:"type"`
+	Address         string       `db:"address"`
+	OriginalVesting *DbCoins     `db:"original_vesting"`
+	EndTime         time.Time    `db:"end_time"`
+	StartTime       sql.NullTime `db:"start_time"`
+}
+
+// VestingPeriodRow represents a single row inside the vesting_period table
+type VestingPeriodRow struct {
+	VestingAccountID int64    `db:"vesting_account_id"`
+	PeriodOrder      int64    `db:"period_order"`
+	Length           int64    `db:"length"`
+	Amount           *DbCoins `db:"amount"`
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// TransactionRow represents a single row inside the transaction table
+type TransactionRow struct {
+	Hash        string         `db:"hash"`
+	Height      int64          `db:"height"`
+	Success     bool           `db:"success"`
+	Messages    string         `db:"messages"`
+	Memo        sql.NullString `db:"memo"`
+	Signatures  pq.StringArray `db:"signatures"`
+	SignerInfos string         `db:"signer_infos"`
+	Fee         string         `db:"fee"`
+	GasWanted   int64          `db:"gas_wanted"`
+	GasUsed     int64          `db:"gas_used"`
+	RawLog      sql.NullString `db:"raw_log"`
+	Logs        sql.NullString `db:"logs"`
+}
@@ -24,6 +24,17 @@ func ToNullString(value string) sql.NullString {
 	}
 }
 
+// ToNullDec converts the given decimal value into a sql.NullString, which is not valid if the value is nil
+func ToNullDec(value *sdk.Dec) sql.NullString {
+	if value == nil || value.IsNil() {
+		return sql.NullString{}
+	}
+	return sql.NullString{
+		Valid:  true,
+		String: value.String(),
+	}
+}
+
 func RemoveEmpty(s []string) []string {
 	var r []string
 	for _, str := range s {
@@ -54,7 +54,7 @@ func (r ConsensusRow) Equal(s ConsensusRow) bool {
 		r.Step == s.Step
 }
 
-// AverageTimeRow is the average block time each minute/hour/day
+// AverageTimeRow is the average block time since genesis
 type AverageTimeRow struct {
 	OneRowID    bool    `db:"one_row_id"`
 	AverageTime float64 `db:"average_time"`
@@ -95,3 +95,17 @@ type BlockHeightAndTimestamp struct {
 	Height         int64     `db:"height"`
 	BlockTimestamp time.Time `db:"timestamp"`
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// BlockTimeSeriesRow represents a single row of the block_time_series table
+type BlockTimeSeriesRow struct {
+	Bucket           time.Time `db:"bucket"`
+	BlocksCount      int64     `db:"blocks_count"`
+	MinBlockTime     string    `db:"min_block_time"`
+	MaxBlockTime     string    `db:"max_block_time"`
+	AverageBlockTime string    `db:"average_block_time"`
+	P95BlockTime     string    `db:"p95_block_time"`
+	FirstHeight      int64     `db:"first_height"`
+	LastHeight       int64     `db:"last_height"`
+}
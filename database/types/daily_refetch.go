@@ -0,0 +1,15 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+)
+
+// MissingBlockRow represents a single row inside the missing_block table
+type MissingBlockRow struct {
+	Height      int64          `db:"height"`
+	RetryCount  int            `db:"retry_count"`
+	LastError   sql.NullString `db:"last_error"`
+	NextRetryAt time.Time      `db:"next_retry_at"`
+	DetectedAt  time.Time      `db:"detected_at"`
+}
@@ -30,3 +30,11 @@ func (v CommunityPoolRow) Equals(w CommunityPoolRow) bool {
 	return v.Coins.Equal(w.Coins) &&
 		v.Height == w.Height
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ValidatorRewardsWithdrawnRow represents the total amount of rewards withdrawn from a single validator
+type ValidatorRewardsWithdrawnRow struct {
+	ValidatorAddress string `db:"validator_address"`
+	Amount           string `db:"amount"`
+}
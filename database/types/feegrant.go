@@ -1,10 +1,26 @@
 package types
 
+import "database/sql"
+
 // FeeAllowanceRow represents a single row inside the fee_grant_allowance table
 type FeeAllowanceRow struct {
-	ID        uint64 `db:"id"`
-	Grantee   string `db:"grantee_address"`
-	Granter   string `db:"granter_address"`
-	Allowance string `db:"allowance"`
-	Height    int64  `db:"height"`
+	ID                 uint64        `db:"id"`
+	Grantee            string        `db:"grantee_address"`
+	Granter            string        `db:"granter_address"`
+	Allowance          string        `db:"allowance"`
+	RemainingAllowance string        `db:"remaining_allowance"`
+	Spent              DbCoins       `db:"spent"`
+	Status             string        `db:"status"`
+	Height             int64         `db:"height"`
+	EndHeight          sql.NullInt64 `db:"end_height"`
+}
+
+// FeeGrantUsageRow represents a single row inside the fee_grant_usage table
+type FeeGrantUsageRow struct {
+	TxHash      string        `db:"transaction_hash"`
+	AllowanceID sql.NullInt64 `db:"allowance_id"`
+	Grantee     string        `db:"grantee_address"`
+	Granter     string        `db:"granter_address"`
+	Fee         DbCoins       `db:"fee"`
+	Height      int64         `db:"height"`
 }
@@ -27,6 +27,9 @@ type ProposalRow struct {
 	VotingEndTime   sql.NullTime `db:"voting_end_time"`
 	Proposer        string       `db:"proposer_address"`
 	Status          string       `db:"status"`
+
+	// SearchVector is computed by the database, and it is not considered when comparing two rows
+	SearchVector sql.NullString `db:"search_vector"`
 }
 
 // NewProposalRow allows to easily create a new ProposalRow
@@ -112,6 +115,61 @@ func (w TallyResultRow) Equals(v TallyResultRow) bool {
 		w.Height == v.Height
 }
 
+// ProposalTallyProjectionRow represents a single row inside the proposal_tally_projection table
+type ProposalTallyProjectionRow struct {
+	ProposalID      int64   `db:"proposal_id"`
+	Turnout         float64 `db:"turnout"`
+	Quorum          float64 `db:"quorum"`
+	QuorumReached   bool    `db:"quorum_reached"`
+	YesRatio        float64 `db:"yes_ratio"`
+	Threshold       float64 `db:"threshold"`
+	VetoRatio       float64 `db:"veto_ratio"`
+	VetoThreshold   float64 `db:"veto_threshold"`
+	ProjectedStatus string  `db:"projected_status"`
+	Height          int64   `db:"height"`
+}
+
+// NewProposalTallyProjectionRow returns a new ProposalTallyProjectionRow instance
+func NewProposalTallyProjectionRow(
+	proposalID int64,
+	turnout float64,
+	quorum float64,
+	quorumReached bool,
+	yesRatio float64,
+	threshold float64,
+	vetoRatio float64,
+	vetoThreshold float64,
+	projectedStatus string,
+	height int64,
+) ProposalTallyProjectionRow {
+	return ProposalTallyProjectionRow{
+		ProposalID:      proposalID,
+		Turnout:         turnout,
+		Quorum:          quorum,
+		QuorumReached:   quorumReached,
+		YesRatio:        yesRatio,
+		Threshold:       threshold,
+		VetoRatio:       vetoRatio,
+		VetoThreshold:   vetoThreshold,
+		ProjectedStatus: projectedStatus,
+		Height:          height,
+	}
+}
+
+// Equals return true if two ProposalTallyProjectionRow are the same
+func (w ProposalTallyProjectionRow) Equals(v ProposalTallyProjectionRow) bool {
+	return w.ProposalID == v.ProposalID &&
+		w.Turnout == v.Turnout &&
+		w.Quorum == v.Quorum &&
+		w.QuorumReached == v.QuorumReached &&
+		w.YesRatio == v.YesRatio &&
+		w.Threshold == v.Threshold &&
+		w.VetoRatio == v.VetoRatio &&
+		w.VetoThreshold == v.VetoThreshold &&
+		w.ProjectedStatus == v.ProjectedStatus &&
+		w.Height == v.Height
+}
+
 // VoteRow represents a single row inside the vote table
 type VoteRow struct {
 	ProposalID int64     `db:"proposal_id"`
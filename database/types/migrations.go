@@ -0,0 +1,10 @@
+package types
+
+import "time"
+
+// SchemaMigrationRow represents a single row inside the schema_migrations table
+type SchemaMigrationRow struct {
+	Version   int64     `db:"version"`
+	Name      string    `db:"name"`
+	AppliedAt time.Time `db:"applied_at"`
+}
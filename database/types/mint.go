@@ -1,5 +1,9 @@
 package types
 
+import (
+	"time"
+)
+
 // InflationRow represents a single row inside the inflation table
 type InflationRow struct {
 	OneRowID bool    `db:"one_row_id"`
@@ -47,3 +51,46 @@ func (m MintParamsRow) Equal(n MintParamsRow) bool {
 	return m.Params == n.Params &&
 		m.Height == n.Height
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// InflationHistoryRow represents a single row inside the inflation_history table
+type InflationHistoryRow struct {
+	Height           int64     `db:"height"`
+	Inflation        float64   `db:"inflation"`
+	AnnualProvisions float64   `db:"annual_provisions"`
+	Timestamp        time.Time `db:"timestamp"`
+}
+
+// NewInflationHistoryRow builds a new InflationHistoryRow instance
+func NewInflationHistoryRow(
+	inflation float64, annualProvisions float64, height int64, timestamp time.Time,
+) InflationHistoryRow {
+	return InflationHistoryRow{
+		Height:           height,
+		Inflation:        inflation,
+		AnnualProvisions: annualProvisions,
+		Timestamp:        timestamp,
+	}
+}
+
+// Equal reports whether i and j represent the same table rows.
+func (i InflationHistoryRow) Equal(j InflationHistoryRow) bool {
+	return i.Height == j.Height &&
+		i.Inflation == j.Inflation &&
+		i.AnnualProvisions == j.AnnualProvisions &&
+		i.Timestamp.Equal(j.Timestamp)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SupplyProjectionRow represents a single row inside the supply_projection table
+type SupplyProjectionRow struct {
+	Day              int       `db:"day"`
+	Timestamp        time.Time `db:"timestamp"`
+	ProjectedHeight  int64     `db:"projected_height"`
+	Inflation        float64   `db:"inflation"`
+	AnnualProvisions float64   `db:"annual_provisions"`
+	Supply           float64   `db:"supply"`
+	Height           int64     `db:"height"`
+}
@@ -0,0 +1,17 @@
+package types
+
+// ParamsHistoryRow represents a single row of the params_history table
+type ParamsHistoryRow struct {
+	ModuleName string `db:"module_name"`
+	Params     string `db:"params"`
+	Height     int64  `db:"height"`
+}
+
+// NewParamsHistoryRow allows to build a new ParamsHistoryRow instance
+func NewParamsHistoryRow(moduleName string, params string, height int64) ParamsHistoryRow {
+	return ParamsHistoryRow{
+		ModuleName: moduleName,
+		Params:     params,
+		Height:     height,
+	}
+}
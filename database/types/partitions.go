@@ -0,0 +1,18 @@
+package types
+
+import "database/sql"
+
+// PartitionRow represents a single partition of a partitioned table
+type PartitionRow struct {
+	Name         string `db:"name"`
+	Bound        string `db:"bound"`
+	Size         int64  `db:"size"`
+	RowsEstimate int64  `db:"rows_estimate"`
+}
+
+// PartitionHeightRange contains the lowest and highest heights stored inside a partition.
+// Both heights are null if the partition is empty
+type PartitionHeightRange struct {
+	MinHeight sql.NullInt64 `db:"min_height"`
+	MaxHeight sql.NullInt64 `db:"max_height"`
+}
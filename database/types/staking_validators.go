@@ -149,6 +149,9 @@ type ValidatorDescriptionRow struct {
 	SecurityContact sql.NullString `db:"security_contact"`
 	Details         sql.NullString `db:"details"`
 	Height          int64          `db:"height"`
+
+	// SearchVector is computed by the database, and it is not considered when comparing two rows
+	SearchVector sql.NullString `db:"search_vector"`
 }
 
 // NewValidatorDescriptionRow return a row representing data structure in validator_description
@@ -334,3 +337,15 @@ func (v DoubleSignEvidenceRow) Equal(w DoubleSignEvidenceRow) bool {
 		v.VoteBID == w.VoteBID &&
 		v.Height == w.Height
 }
+
+//--------------------------------------------------------
+
+// ValidatorStakingDataRow contains the latest commission, voting power and status of a single validator
+type ValidatorStakingDataRow struct {
+	ConsensusAddress string         `db:"consensus_address"`
+	OperatorAddress  string         `db:"operator_address"`
+	Commission       sql.NullString `db:"commission"`
+	VotingPower      int64          `db:"voting_power"`
+	Status           int            `db:"status"`
+	Jailed           bool           `db:"jailed"`
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"database/sql"
+)
+
 type SoftwareUpgradePlanRow struct {
 	ProposalID    uint64 `db:"proposal_id"`
 	PlanName      string `db:"plan_name"`
@@ -19,3 +23,31 @@ func NewSoftwareUpgradePlanRow(
 		Height:        height,
 	}
 }
+
+// UpgradeHistoryRow represents a single row of the upgrade_history table
+type UpgradeHistoryRow struct {
+	ProposalID       uint64         `db:"proposal_id"`
+	PlanName         string         `db:"plan_name"`
+	UpgradeHeight    int64          `db:"upgrade_height"`
+	Info             string         `db:"info"`
+	BinaryInfo       sql.NullString `db:"binary_info"`
+	Status           string         `db:"status"`
+	CancelProposalID sql.NullInt64  `db:"cancel_proposal_id"`
+	AppliedHeight    sql.NullInt64  `db:"applied_height"`
+	AppliedTime      sql.NullTime   `db:"applied_time"`
+	Height           int64          `db:"height"`
+}
+
+// Equals returns true if this row is equal to the given one
+func (r UpgradeHistoryRow) Equals(s UpgradeHistoryRow) bool {
+	return r.ProposalID == s.ProposalID &&
+		r.PlanName == s.PlanName &&
+		r.UpgradeHeight == s.UpgradeHeight &&
+		r.Info == s.Info &&
+		r.BinaryInfo.Valid == s.BinaryInfo.Valid &&
+		r.Status == s.Status &&
+		r.CancelProposalID == s.CancelProposalID &&
+		r.AppliedHeight == s.AppliedHeight &&
+		AreNullTimesEqual(r.AppliedTime, s.AppliedTime) &&
+		r.Height == s.Height
+}
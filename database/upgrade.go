@@ -0,0 +1,90 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// SaveScheduledUpgrade stores inside the upgrade history the given plan, scheduled by the proposal having the given id.
+// Since scheduling a new plan replaces the existing one, any other plan that is still scheduled is marked as cancelled
+func (db *Db) SaveScheduledUpgrade(proposalID uint64, plan upgradetypes.Plan, height int64) error {
+	cancelStmt := `
+UPDATE upgrade_history
+SET status = $1, cancel_proposal_id = $2, height = $3
+WHERE status = $4 AND proposal_id != $2 AND height <= $3`
+
+	// The info field usually contains the JSON description of the upgrade binaries, but it can be any string
+	var binaryInfo = ""
+	if json.Valid([]byte(plan.Info)) {
+		binaryInfo = plan.Info
+	}
+
+	stmt := `
+INSERT INTO upgrade_history (proposal_id, plan_name, upgrade_height, info, binary_info, status, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (proposal_id) DO UPDATE
+	SET plan_name = excluded.plan_name,
+		upgrade_height = excluded.upgrade_height,
+		info = excluded.info,
+		binary_info = excluded.binary_info,
+		height = excluded.height
+WHERE upgrade_history.status = excluded.status AND upgrade_history.height <= excluded.height`
+
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(cancelStmt,
+			types.UpgradeStatusCancelled, proposalID, height, types.UpgradeStatusScheduled)
+		if err != nil {
+			return fmt.Errorf("error while cancelling replaced upgrade plans: %s", err)
+		}
+
+		_, err = tx.Exec(stmt,
+			proposalID, plan.Name, plan.Height, plan.Info, dbtypes.ToNullString(binaryInfo),
+			types.UpgradeStatusScheduled, height,
+		)
+		if err != nil {
+			return fmt.Errorf("error while storing upgrade history for proposal %d: %s", proposalID, err)
+		}
+
+		return nil
+	})
+}
+
+// SaveCancelledUpgrade marks all the upgrade plans that are still scheduled as cancelled by the proposal
+// having the given id
+func (db *Db) SaveCancelledUpgrade(cancelProposalID uint64, height int64) error {
+	stmt := `
+UPDATE upgrade_history
+SET status = $1, cancel_proposal_id = $2, height = $3
+WHERE status = $4 AND height <= $3`
+	return db.execBlockOperation(height, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt,
+			types.UpgradeStatusCancelled, cancelProposalID, height, types.UpgradeStatusScheduled)
+		if err != nil {
+			return fmt.Errorf("error while cancelling upgrade plans: %s", err)
+		}
+		return nil
+	})
+}
+
+// SaveAppliedUpgrade marks the upgrade plans scheduled at the given height as applied at the given time
+func (db *Db) SaveAppliedUpgrade(upgradeHeight int64, timestamp time.Time) error {
+	stmt := `
+UPDATE upgrade_history
+SET status = $1, applied_height = $2, applied_time = $3, height = $2
+WHERE status = $4 AND upgrade_height = $2`
+	return db.execBlockOperation(upgradeHeight, func(tx *sql.Tx) error {
+		_, err := tx.Exec(stmt,
+			types.UpgradeStatusApplied, upgradeHeight, timestamp, types.UpgradeStatusScheduled)
+		if err != nil {
+			return fmt.Errorf("error while storing applied upgrade at height %d: %s", upgradeHeight, err)
+		}
+		return nil
+	})
+}
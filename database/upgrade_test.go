@@ -0,0 +1,138 @@
+package database_test
+
+import (
+	"database/sql"
+	"time"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func (suite *DbTestSuite) getUpgradeHistory() []dbtypes.UpgradeHistoryRow {
+	var rows []dbtypes.UpgradeHistoryRow
+	err := suite.database.Sqlx.Select(&rows, `SELECT * FROM upgrade_history ORDER BY proposal_id`)
+	suite.Require().NoError(err)
+	return rows
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveScheduledUpgrade() {
+	_ = suite.getProposalRow(1)
+	_ = suite.getProposalRow(2)
+
+	plan := upgradetypes.Plan{
+		Name:   "v2",
+		Height: 100,
+		Info:   `{"binaries":{"linux/amd64":"https://example.com/v2"}}`,
+	}
+	err := suite.database.SaveScheduledUpgrade(1, plan, 10)
+	suite.Require().NoError(err)
+
+	rows := suite.getUpgradeHistory()
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.UpgradeHistoryRow{
+		ProposalID:    1,
+		PlanName:      "v2",
+		UpgradeHeight: 100,
+		Info:          plan.Info,
+		BinaryInfo:    sql.NullString{String: plan.Info, Valid: true},
+		Status:        types.UpgradeStatusScheduled,
+		Height:        10,
+	}))
+
+	// Schedule a new plan, replacing the existing one
+	newPlan := upgradetypes.Plan{
+		Name:   "v2.1",
+		Height: 120,
+		Info:   "https://example.com/v2.1",
+	}
+	err = suite.database.SaveScheduledUpgrade(2, newPlan, 20)
+	suite.Require().NoError(err)
+
+	rows = suite.getUpgradeHistory()
+	suite.Require().Len(rows, 2)
+	suite.Require().True(rows[0].Equals(dbtypes.UpgradeHistoryRow{
+		ProposalID:       1,
+		PlanName:         "v2",
+		UpgradeHeight:    100,
+		Info:             plan.Info,
+		BinaryInfo:       sql.NullString{String: plan.Info, Valid: true},
+		Status:           types.UpgradeStatusCancelled,
+		CancelProposalID: sql.NullInt64{Int64: 2, Valid: true},
+		Height:           20,
+	}))
+	suite.Require().True(rows[1].Equals(dbtypes.UpgradeHistoryRow{
+		ProposalID:    2,
+		PlanName:      "v2.1",
+		UpgradeHeight: 120,
+		Info:          newPlan.Info,
+		Status:        types.UpgradeStatusScheduled,
+		Height:        20,
+	}))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCancelledUpgrade() {
+	_ = suite.getProposalRow(1)
+	_ = suite.getProposalRow(2)
+
+	plan := upgradetypes.Plan{Name: "v2", Height: 100, Info: "info"}
+	err := suite.database.SaveScheduledUpgrade(1, plan, 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveCancelledUpgrade(2, 20)
+	suite.Require().NoError(err)
+
+	rows := suite.getUpgradeHistory()
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.UpgradeHistoryRow{
+		ProposalID:       1,
+		PlanName:         "v2",
+		UpgradeHeight:    100,
+		Info:             "info",
+		Status:           types.UpgradeStatusCancelled,
+		CancelProposalID: sql.NullInt64{Int64: 2, Valid: true},
+		Height:           20,
+	}))
+
+	// Make sure the upgrade is not applied once cancelled
+	err = suite.database.SaveAppliedUpgrade(100, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC))
+	suite.Require().NoError(err)
+
+	rows = suite.getUpgradeHistory()
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(types.UpgradeStatusCancelled, rows[0].Status)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAppliedUpgrade() {
+	_ = suite.getProposalRow(1)
+
+	plan := upgradetypes.Plan{Name: "v2", Height: 100, Info: "info"}
+	err := suite.database.SaveScheduledUpgrade(1, plan, 10)
+	suite.Require().NoError(err)
+
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	err = suite.database.SaveAppliedUpgrade(100, timestamp)
+	suite.Require().NoError(err)
+
+	rows := suite.getUpgradeHistory()
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.UpgradeHistoryRow{
+		ProposalID:    1,
+		PlanName:      "v2",
+		UpgradeHeight: 100,
+		Info:          "info",
+		Status:        types.UpgradeStatusApplied,
+		AppliedHeight: sql.NullInt64{Int64: 100, Valid: true},
+		AppliedTime:   sql.NullTime{Time: timestamp, Valid: true},
+		Height:        100,
+	}))
+
+	// Make sure scheduling the same plan again does not change its status
+	err = suite.database.SaveScheduledUpgrade(1, plan, 10)
+	suite.Require().NoError(err)
+
+	rows = suite.getUpgradeHistory()
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(types.UpgradeStatusApplied, rows[0].Status)
+}
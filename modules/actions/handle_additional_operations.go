@@ -16,13 +16,21 @@ var (
 
 func (m *Module) RunAdditionalOperations() error {
 	// Build the worker
-	context := actionstypes.NewContext(m.node, m.sources)
+	context := actionstypes.NewContext(m.node, m.sources, m.db)
 	worker := actionstypes.NewActionsWorker(context)
 
 	// Register the endpoints
 
+	// -- Analytics --
+	worker.RegisterHandler("/fee_suggestion", handlers.FeeSuggestionHandler)
+
+	// -- Auth --
+	worker.RegisterHandler("/vesting_schedule", handlers.VestingScheduleHandler)
+
 	// -- Bank --
 	worker.RegisterHandler("/account_balance", handlers.AccountBalanceHandler)
+	worker.RegisterPlainHandler("/total_supply", handlers.TotalSupplyHandler)
+	worker.RegisterPlainHandler("/circulating_supply", handlers.CirculatingSupplyHandler)
 
 	// -- Distribution --
 	worker.RegisterHandler("/delegation_reward", handlers.DelegationRewardHandler)
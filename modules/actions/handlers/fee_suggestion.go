@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+	"github.com/forbole/callisto/v4/modules/analytics"
+)
+
+func FeeSuggestionHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Uint64("gas", payload.Input.Gas).
+		Str("denom", payload.Input.Denom).
+		Msg("executing fee suggestion action")
+
+	gasPrices, err := ctx.Db.GetLatestHourlyGasPrices()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting latest gas prices: %s", err)
+	}
+
+	suggestions := make([]types.FeeSuggestion, 0)
+	for _, gasPrice := range gasPrices {
+		if payload.Input.Denom != "" && gasPrice.Denom != payload.Input.Denom {
+			continue
+		}
+
+		low, average, high := analytics.GetFeeSuggestion(gasPrice, payload.Input.Gas)
+		suggestions = append(suggestions, types.FeeSuggestion{
+			Denom:   gasPrice.Denom,
+			Gas:     payload.Input.Gas,
+			Low:     low.String(),
+			Average: average.String(),
+			High:    high.String(),
+		})
+	}
+
+	return suggestions, nil
+}
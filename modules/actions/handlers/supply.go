@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+)
+
+// TotalSupplyHandler returns the latest total supply of the denom given inside the query
+func TotalSupplyHandler(ctx *types.Context, query url.Values) (string, error) {
+	log.Debug().Str("denom", query.Get("denom")).Msg("executing total supply action")
+
+	supply, err := ctx.Db.GetReplicaSupply()
+	if err != nil {
+		return "", err
+	}
+
+	return formatSupplyAmount(supply, query)
+}
+
+// CirculatingSupplyHandler returns the latest circulating supply of the denom given inside the query
+func CirculatingSupplyHandler(ctx *types.Context, query url.Values) (string, error) {
+	log.Debug().Str("denom", query.Get("denom")).Msg("executing circulating supply action")
+
+	supply, err := ctx.Db.GetReplicaCirculatingSupply()
+	if err != nil {
+		return "", err
+	}
+
+	return formatSupplyAmount(supply, query)
+}
+
+// formatSupplyAmount returns the amount of the denom given inside the query as a plain number.
+// If the exponent query parameter is set, the amount is converted from the base unit
+// (eg. 1000000 with exponent 6 is returned as 1)
+func formatSupplyAmount(supply sdk.Coins, query url.Values) (string, error) {
+	denom := query.Get("denom")
+	if denom == "" {
+		return "", fmt.Errorf("missing denom")
+	}
+
+	var exponent int64
+	if value := query.Get("exponent"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 || parsed > sdk.Precision {
+			return "", fmt.Errorf("invalid exponent: %s", value)
+		}
+		exponent = parsed
+	}
+
+	amount := supply.AmountOf(denom)
+	if exponent == 0 {
+		return amount.String(), nil
+	}
+
+	value := sdk.NewDecFromIntWithPrec(amount, exponent).String()
+	return strings.TrimSuffix(strings.TrimRight(value, "0"), "."), nil
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/actions/types"
+	"github.com/forbole/callisto/v4/modules/auth"
+)
+
+func VestingScheduleHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Int64("height", payload.Input.Height).
+		Msg("executing vesting schedule action")
+
+	account, err := ctx.Db.GetVestingAccount(payload.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, fmt.Errorf("vesting account not found: %s", payload.GetAddress())
+	}
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := ctx.Sources.BankSource.GetAccountBalance(payload.GetAddress(), height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account balance: %s", err)
+	}
+
+	// Delegated vesting coins are not stored, so they are read from the account at the requested height
+	chainAccount, err := ctx.Sources.AuthSource.GetAccount(payload.GetAddress(), height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account: %s", err)
+	}
+
+	var delegatedVesting sdk.Coins
+	if vestingAccount, ok := chainAccount.(exported.VestingAccount); ok {
+		delegatedVesting = vestingAccount.GetDelegatedVesting()
+	}
+
+	timestamp, err := ctx.GetBlockTimestamp(height)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := auth.GetVestingAmounts(account, balance, delegatedVesting, timestamp)
+
+	unlocks := auth.GetVestingUnlocks(account)
+	unlocksResponse := make([]types.VestingUnlock, len(unlocks))
+	for i, unlock := range unlocks {
+		unlocksResponse[i] = types.VestingUnlock{
+			StartTime: unlock.StartTime,
+			EndTime:   unlock.EndTime,
+			Amount:    types.ConvertCoins(unlock.Amount),
+		}
+	}
+
+	return types.VestingSchedule{
+		Address:         payload.GetAddress(),
+		Type:            proto.MessageName(account),
+		StartTime:       time.Unix(account.GetStartTime(), 0).UTC(),
+		EndTime:         time.Unix(account.GetEndTime(), 0).UTC(),
+		Timestamp:       amounts.Timestamp,
+		OriginalVesting: types.ConvertCoins(amounts.OriginalVesting),
+		Vested:          types.ConvertCoins(amounts.Vested),
+		Locked:          types.ConvertCoins(amounts.Locked),
+		Spendable:       types.ConvertCoins(amounts.Spendable),
+		Unlocks:         unlocksResponse,
+	}, nil
+}
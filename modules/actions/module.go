@@ -8,6 +8,7 @@ import (
 	"github.com/forbole/juno/v5/types/config"
 	"github.com/forbole/juno/v5/types/params"
 
+	"github.com/forbole/callisto/v4/database"
 	modulestypes "github.com/forbole/callisto/v4/modules/types"
 )
 
@@ -24,9 +25,10 @@ type Module struct {
 	cfg     *Config
 	node    node.Node
 	sources *modulestypes.Sources
+	db      *database.Db
 }
 
-func NewModule(cfg config.Config, encodingConfig params.EncodingConfig) *Module {
+func NewModule(cfg config.Config, encodingConfig params.EncodingConfig, db *database.Db) *Module {
 	bz, err := cfg.GetBytes()
 	if err != nil {
 		panic(err)
@@ -58,6 +60,7 @@ func NewModule(cfg config.Config, encodingConfig params.EncodingConfig) *Module
 		cfg:     actionsCfg,
 		node:    junoNode,
 		sources: sources,
+		db:      db,
 	}
 }
 
@@ -2,9 +2,12 @@ package types
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/forbole/juno/v5/node"
 
+	"github.com/forbole/callisto/v4/database"
 	modulestypes "github.com/forbole/callisto/v4/modules/types"
 )
 
@@ -12,13 +15,15 @@ import (
 type Context struct {
 	node    node.Node
 	Sources *modulestypes.Sources
+	Db      *database.Db
 }
 
 // NewContext returns a new Context instance
-func NewContext(node node.Node, sources *modulestypes.Sources) *Context {
+func NewContext(node node.Node, sources *modulestypes.Sources, db *database.Db) *Context {
 	return &Context{
 		node:    node,
 		Sources: sources,
+		Db:      db,
 	}
 }
 
@@ -35,6 +40,20 @@ func (c *Context) GetHeight(payload *Payload) (int64, error) {
 	return payload.Input.Height, nil
 }
 
+// GetBlockTimestamp returns the timestamp of the block having the given height
+func (c *Context) GetBlockTimestamp(height int64) (time.Time, error) {
+	block, err := c.node.Block(height)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error while getting block: %s", err)
+	}
+
+	return block.Block.Time.UTC(), nil
+}
+
 // ActionHandler represents a Hasura action request handler.
 // It returns an interface to be returned to the called, or an error if something is wrong
 type ActionHandler = func(context *Context, payload *Payload) (interface{}, error)
+
+// PlainHandler represents a request handler that returns a plain text value instead of a Hasura action response.
+// It receives the query parameters of the request, and returns the text to be written or an error if something is wrong
+type PlainHandler = func(context *Context, query url.Values) (string, error)
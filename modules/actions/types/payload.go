@@ -28,4 +28,6 @@ type PayloadArgs struct {
 	Offset     uint64 `json:"offset"`
 	Limit      uint64 `json:"limit"`
 	CountTotal bool   `json:"count_total"`
+	Gas        uint64 `json:"gas"`
+	Denom      string `json:"denom"`
 }
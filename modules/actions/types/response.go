@@ -99,3 +99,34 @@ type RedelegationEntry struct {
 	CompletionTime time.Time   `json:"completion_time"`
 	Balance        sdkmath.Int `json:"balance"`
 }
+
+// ========================= Vesting Schedule Response =========================
+
+type VestingSchedule struct {
+	Address         string          `json:"address"`
+	Type            string          `json:"type"`
+	StartTime       time.Time       `json:"start_time"`
+	EndTime         time.Time       `json:"end_time"`
+	Timestamp       time.Time       `json:"timestamp"`
+	OriginalVesting []Coin          `json:"original_vesting"`
+	Vested          []Coin          `json:"vested"`
+	Locked          []Coin          `json:"locked"`
+	Spendable       []Coin          `json:"spendable"`
+	Unlocks         []VestingUnlock `json:"unlocks"`
+}
+
+type VestingUnlock struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Amount    []Coin    `json:"amount"`
+}
+
+// ========================= Fee Suggestion Response =========================
+
+type FeeSuggestion struct {
+	Denom   string `json:"denom"`
+	Gas     uint64 `json:"gas"`
+	Low     string `json:"low"`
+	Average string `json:"average"`
+	High    string `json:"high"`
+}
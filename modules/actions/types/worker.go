@@ -76,6 +76,38 @@ func (w *ActionsWorker) RegisterHandler(path string, handler ActionHandler) {
 	})
 }
 
+// RegisterPlainHandler registers the provided plain text handler to be used on each GET call to the provided path
+func (w *ActionsWorker) RegisterPlainHandler(path string, handler PlainHandler) {
+	log.Debug().Str("endpoint", path).Msg("registering plain handler")
+	w.mux.HandleFunc(path, func(writer http.ResponseWriter, request *http.Request) {
+		start := time.Now()
+
+		if request.Method != http.MethodGet {
+			http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Set the content type
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+		// Handle the request
+		res, err := handler(w.context, request.URL.Query())
+		if err != nil {
+			logging.ErrorCounter(path)
+			log.Error().Str("endpoint", path).Err(err).Msg("error while executing plain handler")
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Prometheus
+		logging.SuccessCounter(path)
+		logging.ReponseTimeBuckets(path, start)
+
+		// Write the response
+		writer.Write([]byte(res))
+	})
+}
+
 // handleError allows to handle the given error by writing it to the provided writer
 func (w *ActionsWorker) handleError(writer http.ResponseWriter, path string, err error) {
 	log.Error().Str("action", path).
@@ -0,0 +1,56 @@
+package analytics
+
+import (
+	"fmt"
+	"time"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, txs []*juno.Tx, _ *tmctypes.ResultValidators,
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
+	// Skip the blocks without transactions
+	if len(txs) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "analytics").Int64("height", b.Block.Height).
+		Msg("updating chain activity")
+
+	activity := GetBlockActivity(b.Block.Height, b.Block.Time, txs)
+
+	// The accounts involved inside the transactions are stored after the blocks are handled,
+	// so the ones that are not yet stored are appearing for the first time
+	var addresses []string
+	for _, tx := range txs {
+		txAddresses, err := m.messagesParser(tx)
+		if err != nil {
+			log.Error().Str("module", "analytics").Err(err).Str("tx_hash", tx.TxHash).
+				Msg("error while getting transaction involved addresses")
+			continue
+		}
+		addresses = append(addresses, utils.FilterNonAccountAddresses(txAddresses)...)
+	}
+
+	newAccounts, err := m.db.CountNewAccounts(addresses)
+	if err != nil {
+		return fmt.Errorf("error while counting new accounts: %s", err)
+	}
+	activity.NewAccounts = newAccounts
+
+	err = m.db.SaveBlockActivity(activity)
+	if err != nil {
+		return fmt.Errorf("error while saving block activity: %s", err)
+	}
+
+	feeMarket, gasPrices := GetBlockFeeMarket(b.Block.Height, b.Block.Time, txs)
+	return m.db.SaveBlockFeeMarket(feeMarket, gasPrices)
+}
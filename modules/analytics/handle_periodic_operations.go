@@ -0,0 +1,23 @@
+package analytics
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "analytics").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethodSingleton(m.UpdateHourlyFeeMarket)
+	}); err != nil {
+		return fmt.Errorf("error while setting up analytics periodic operation: %s", err)
+	}
+
+	return nil
+}
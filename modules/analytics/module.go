@@ -0,0 +1,33 @@
+package analytics
+
+import (
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/modules/messages"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.BlockModule              = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module that keeps track of the chain activity
+type Module struct {
+	db             *database.Db
+	messagesParser messages.MessageAddressesParser
+}
+
+// NewModule builds a new Module instance
+func NewModule(messagesParser messages.MessageAddressesParser, db *database.Db) *Module {
+	return &Module{
+		messagesParser: messagesParser,
+		db:             db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "analytics"
+}
@@ -0,0 +1,46 @@
+package analytics
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// GetBlockActivity returns the chain activity of the block having the given height, timestamp and transactions.
+// The number of new accounts is left to zero, since it depends on the accounts already stored
+func GetBlockActivity(height int64, timestamp time.Time, txs []*juno.Tx) types.BlockActivity {
+	var successfulTxs, failedTxs, gasUsed, gasWanted int64
+	var signers []string
+	seenSigners := map[string]bool{}
+	fees := sdk.NewCoins()
+
+	for _, tx := range txs {
+		if tx.Successful() {
+			successfulTxs++
+		} else {
+			failedTxs++
+		}
+
+		gasUsed += tx.GasUsed
+		gasWanted += tx.GasWanted
+
+		if tx.AuthInfo != nil && tx.AuthInfo.Fee != nil {
+			fees = fees.Add(tx.AuthInfo.Fee.Amount...)
+		}
+
+		for _, signer := range tx.Tx.GetSigners() {
+			if !seenSigners[signer.String()] {
+				signers = append(signers, signer.String())
+				seenSigners[signer.String()] = true
+			}
+		}
+	}
+
+	return types.NewBlockActivity(
+		int64(len(txs)), successfulTxs, failedTxs, signers, 0, fees,
+		gasUsed, gasWanted, height, timestamp.UTC(),
+	)
+}
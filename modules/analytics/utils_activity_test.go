@@ -0,0 +1,55 @@
+package analytics_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/analytics"
+)
+
+func buildTx(t *testing.T, sender string, code uint32, fees sdk.Coins, gasUsed, gasWanted int64) *juno.Tx {
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: sender,
+		ToAddress:   "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+	})
+	require.NoError(t, err)
+
+	return &juno.Tx{
+		Tx: &txtypes.Tx{
+			Body:     &txtypes.TxBody{Messages: []*codectypes.Any{msg}},
+			AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{Amount: fees}},
+		},
+		TxResponse: &sdk.TxResponse{Code: code, GasUsed: gasUsed, GasWanted: gasWanted},
+	}
+}
+
+func TestGetBlockActivity(t *testing.T) {
+	first := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+	second := "cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a"
+	timestamp := time.Date(2020, 1, 1, 15, 30, 0, 0, time.UTC)
+
+	txs := []*juno.Tx{
+		buildTx(t, first, 0, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), 1000, 2000),
+		buildTx(t, first, 5, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("udsm", 10)), 500, 1000),
+		buildTx(t, second, 0, nil, 300, 400),
+	}
+
+	activity := analytics.GetBlockActivity(10, timestamp, txs)
+	require.Equal(t, int64(3), activity.TxsCount)
+	require.Equal(t, int64(2), activity.SuccessfulTxs)
+	require.Equal(t, int64(1), activity.FailedTxs)
+	require.Equal(t, []string{first, second}, activity.Signers)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 150), sdk.NewInt64Coin("udsm", 10)), activity.Fees)
+	require.Equal(t, int64(1800), activity.GasUsed)
+	require.Equal(t, int64(3400), activity.GasWanted)
+	require.Equal(t, int64(10), activity.Height)
+	require.Equal(t, timestamp, activity.Timestamp)
+}
@@ -0,0 +1,141 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// feeMarketChunk represents the time range of blocks used to compute the hourly fee market statistics at once,
+// so that long ranges are computed in smaller steps that can be resumed
+const feeMarketChunk = 24 * time.Hour
+
+var (
+	medianPercentile = sdk.NewDecWithPrec(5, 1)
+	p90Percentile    = sdk.NewDecWithPrec(9, 1)
+)
+
+// UpdateHourlyFeeMarket computes the hourly fee market statistics starting from the latest stored hour,
+// or from the first stored block if no hour has been stored yet
+func (m *Module) UpdateHourlyFeeMarket() error {
+	log.Trace().Str("module", "analytics").Str("operation", "fee market").
+		Msg("updating hourly fee market")
+
+	lastBlock, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no blocks yet
+	if lastBlock.Height == 0 {
+		return nil
+	}
+
+	// Recompute the latest hour since it might have been computed before it was complete
+	lastBucket, err := m.db.GetLastFeeMarketHourlyBucket()
+	if err != nil {
+		return err
+	}
+
+	var start time.Time
+	if lastBucket != nil {
+		start = *lastBucket
+	} else {
+		firstBlock, err := m.db.GetFirstBlockHeightAndTimestamp()
+		if err != nil {
+			return err
+		}
+		start = firstBlock.BlockTimestamp.UTC().Truncate(time.Hour)
+	}
+
+	for from := start; !from.After(lastBlock.BlockTimestamp); from = from.Add(feeMarketChunk) {
+		err = m.db.SaveHourlyFeeMarket(from, from.Add(feeMarketChunk), lastBlock.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetBlockFeeMarket returns the fee market statistics and the gas prices of the given transactions
+// included inside the block having the given height and timestamp.
+// The effective gas price of a transaction is given by its fee amount divided by its gas wanted
+func GetBlockFeeMarket(height int64, timestamp time.Time, txs []*juno.Tx) (types.FeeMarket, []types.GasPrice) {
+	var gasUsed, gasWanted, outOfGasTxs int64
+	var denoms []string
+	prices := map[string][]sdk.Dec{}
+
+	for _, tx := range txs {
+		gasUsed += tx.GasUsed
+		gasWanted += tx.GasWanted
+
+		if tx.Codespace == sdkerrors.RootCodespace && tx.Code == sdkerrors.ErrOutOfGas.ABCICode() {
+			outOfGasTxs++
+		}
+
+		if tx.GasWanted <= 0 || tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+			continue
+		}
+
+		for _, fee := range tx.AuthInfo.Fee.Amount {
+			if _, found := prices[fee.Denom]; !found {
+				denoms = append(denoms, fee.Denom)
+			}
+			prices[fee.Denom] = append(prices[fee.Denom], sdk.NewDecFromInt(fee.Amount).QuoInt64(tx.GasWanted))
+		}
+	}
+
+	gasEfficiency := sdk.ZeroDec()
+	if gasWanted > 0 {
+		gasEfficiency = sdk.NewDec(gasUsed).QuoInt64(gasWanted)
+	}
+
+	sort.Strings(denoms)
+	gasPrices := make([]types.GasPrice, len(denoms))
+	for i, denom := range denoms {
+		denomPrices := prices[denom]
+		sort.Slice(denomPrices, func(i, j int) bool { return denomPrices[i].LT(denomPrices[j]) })
+
+		gasPrices[i] = types.NewGasPrice(
+			denom,
+			int64(len(denomPrices)),
+			denomPrices[0],
+			getPercentile(denomPrices, medianPercentile),
+			getPercentile(denomPrices, p90Percentile),
+		)
+	}
+
+	feeMarket := types.NewFeeMarket(
+		int64(len(txs)), gasUsed, gasWanted, gasEfficiency, outOfGasTxs, height, timestamp.UTC(),
+	)
+	return feeMarket, gasPrices
+}
+
+// getPercentile returns the given percentile of the given sorted values,
+// interpolating linearly between the closest ranks
+func getPercentile(sorted []sdk.Dec, percentile sdk.Dec) sdk.Dec {
+	position := percentile.MulInt64(int64(len(sorted) - 1))
+	lower := position.TruncateInt64()
+	if lower+1 >= int64(len(sorted)) {
+		return sorted[lower]
+	}
+
+	fraction := position.Sub(sdk.NewDec(lower))
+	return sorted[lower].Add(sorted[lower+1].Sub(sorted[lower]).Mul(fraction))
+}
+
+// GetFeeSuggestion returns the low, average and high fee amounts to be paid for the given gas amount
+// based on the minimum, median and 90th percentile of the given gas prices
+func GetFeeSuggestion(gasPrice types.GasPrice, gas uint64) (low, average, high sdk.Int) {
+	gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+	return gasPrice.MinGasPrice.Mul(gasDec).Ceil().TruncateInt(),
+		gasPrice.MedianGasPrice.Mul(gasDec).Ceil().TruncateInt(),
+		gasPrice.P90GasPrice.Mul(gasDec).Ceil().TruncateInt()
+}
@@ -0,0 +1,47 @@
+package analytics_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/analytics"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestGetBlockFeeMarket(t *testing.T) {
+	sender := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+	timestamp := time.Date(2020, 1, 1, 15, 30, 0, 0, time.UTC)
+
+	outOfGasTx := buildTx(t, sender, sdkerrors.ErrOutOfGas.ABCICode(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)), 1000, 1000)
+	outOfGasTx.Codespace = sdkerrors.RootCodespace
+
+	txs := []*juno.Tx{
+		buildTx(t, sender, 0, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), 500, 1000),
+		buildTx(t, sender, 0, sdk.NewCoins(sdk.NewInt64Coin("uatom", 20), sdk.NewInt64Coin("udsm", 5)), 500, 1000),
+		outOfGasTx,
+		buildTx(t, sender, 0, nil, 100, 0),
+	}
+
+	feeMarket, gasPrices := analytics.GetBlockFeeMarket(10, timestamp, txs)
+	require.Equal(t, types.NewFeeMarket(4, 2100, 3000, sdk.NewDecWithPrec(7, 1), 1, 10, timestamp), feeMarket)
+	require.Equal(t, []types.GasPrice{
+		types.NewGasPrice("uatom", 3, sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(28, 3)),
+		types.NewGasPrice("udsm", 1, sdk.NewDecWithPrec(5, 3), sdk.NewDecWithPrec(5, 3), sdk.NewDecWithPrec(5, 3)),
+	}, gasPrices)
+}
+
+func TestGetFeeSuggestion(t *testing.T) {
+	gasPrice := types.NewGasPrice(
+		"uatom", 10, sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(25, 4), sdk.NewDecWithPrec(5, 2),
+	)
+
+	low, average, high := analytics.GetFeeSuggestion(gasPrice, 200001)
+	require.Equal(t, sdk.NewInt(201), low)
+	require.Equal(t, sdk.NewInt(501), average)
+	require.Equal(t, sdk.NewInt(10001), high)
+}
@@ -11,10 +11,10 @@ import (
 	"github.com/rs/zerolog/log"
 
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/forbole/callisto/v4/modules/utils"
-	"github.com/forbole/callisto/v4/types"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
@@ -23,7 +23,9 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
+
 	addresses, err := m.messagesParser(tx)
 	if err != nil {
 		log.Error().Str("module", "auth").Err(err).
@@ -31,7 +33,18 @@ func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
 			Msgf("error while refreshing accounts after message of type %s", proto.MessageName(msg))
 	}
 
-	if cosmosMsg, ok := msg.(*vestingtypes.MsgCreateVestingAccount); ok {
+	err = m.handleVestingMsg(msg, tx)
+	if err != nil {
+		return err
+	}
+
+	return m.RefreshAccounts(tx.Height, utils.FilterNonAccountAddresses(addresses))
+}
+
+// handleVestingMsg stores the vesting account created by the given message, if any
+func (m *Module) handleVestingMsg(msg sdk.Msg, tx *juno.Tx) error {
+	switch cosmosMsg := msg.(type) {
+	case *vestingtypes.MsgCreateVestingAccount:
 		// Store tx timestamp as start_time of the created vesting account
 		timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
 		if err != nil {
@@ -42,30 +55,67 @@ func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
 		if err != nil {
 			return fmt.Errorf("error while handling MsgCreateVestingAccount %s", err)
 		}
+
+	case *vestingtypes.MsgCreatePeriodicVestingAccount:
+		err := m.handleMsgCreatePeriodicVestingAccount(cosmosMsg)
+		if err != nil {
+			return fmt.Errorf("error while handling MsgCreatePeriodicVestingAccount %s", err)
+		}
+
+	case *vestingtypes.MsgCreatePermanentLockedAccount:
+		err := m.handleMsgCreatePermanentLockedAccount(cosmosMsg)
+		if err != nil {
+			return fmt.Errorf("error while handling MsgCreatePermanentLockedAccount %s", err)
+		}
 	}
 
-	return m.RefreshAccounts(tx.Height, utils.FilterNonAccountAddresses(addresses))
+	return nil
 }
 
+// handleMsgCreateVestingAccount stores the continuous or delayed vesting account created by the given message
 func (m *Module) handleMsgCreateVestingAccount(msg *vestingtypes.MsgCreateVestingAccount, txTimestamp time.Time) error {
-
 	accAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
 		return fmt.Errorf("error while converting account address %s", err)
 	}
 
-	// store account in database
-	err = m.db.SaveAccounts([]types.Account{types.NewAccount(accAddress.String())})
+	baseAccount := authttypes.NewBaseAccountWithAddress(accAddress)
+
+	var account exported.VestingAccount
+	if msg.Delayed {
+		account = vestingtypes.NewDelayedVestingAccount(baseAccount, msg.Amount, msg.EndTime)
+	} else {
+		account = vestingtypes.NewContinuousVestingAccount(baseAccount, msg.Amount, txTimestamp.Unix(), msg.EndTime)
+	}
+
+	return m.db.SaveVestingAccounts([]exported.VestingAccount{account})
+}
+
+// handleMsgCreatePeriodicVestingAccount stores the periodic vesting account created by the given message
+func (m *Module) handleMsgCreatePeriodicVestingAccount(msg *vestingtypes.MsgCreatePeriodicVestingAccount) error {
+	accAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
-		return fmt.Errorf("error while storing vesting account: %s", err)
+		return fmt.Errorf("error while converting account address %s", err)
 	}
 
-	bva := vestingtypes.NewBaseVestingAccount(
-		authttypes.NewBaseAccountWithAddress(accAddress), msg.Amount, msg.EndTime,
+	var totalCoins sdk.Coins
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	account := vestingtypes.NewPeriodicVestingAccount(
+		authttypes.NewBaseAccountWithAddress(accAddress), totalCoins, msg.StartTime, msg.VestingPeriods,
 	)
-	err = m.db.StoreBaseVestingAccountFromMsg(bva, txTimestamp)
+	return m.db.SaveVestingAccounts([]exported.VestingAccount{account})
+}
+
+// handleMsgCreatePermanentLockedAccount stores the permanent locked account created by the given message
+func (m *Module) handleMsgCreatePermanentLockedAccount(msg *vestingtypes.MsgCreatePermanentLockedAccount) error {
+	accAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
-		return fmt.Errorf("error while storing base vesting account from msg %s", err)
+		return fmt.Errorf("error while converting account address %s", err)
 	}
-	return nil
+
+	account := vestingtypes.NewPermanentLockedAccount(authttypes.NewBaseAccountWithAddress(accAddress), msg.Amount)
+	return m.db.SaveVestingAccounts([]exported.VestingAccount{account})
 }
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// vestingUnlockCalendarDays represents the number of days for which the vesting unlock calendar is computed
+const vestingUnlockCalendarDays = 10 * 365
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "auth").Msg("setting up periodic tasks")
+
+	// Setup a cron job to run every midnight
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.UpdateVestingUnlockCalendar)
+	}); err != nil {
+		return fmt.Errorf("error while setting up vesting unlock calendar periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateVestingUnlockCalendar computes the amounts that all the vesting accounts will unlock during the next days,
+// and stores them inside the database
+func (m *Module) UpdateVestingUnlockCalendar() error {
+	log.Debug().Str("module", "auth").Str("operation", "vesting unlock calendar").
+		Msg("updating vesting unlock calendar")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	accounts, err := m.db.GetVestingAccounts()
+	if err != nil {
+		return err
+	}
+
+	calendar := GetVestingUnlockCalendar(accounts, block.BlockTimestamp, vestingUnlockCalendarDays, block.Height)
+	return m.db.SaveVestingUnlockCalendar(calendar, block.Height)
+}
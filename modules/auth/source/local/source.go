@@ -0,0 +1,53 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/forbole/juno/v5/node/local"
+
+	authsource "github.com/forbole/callisto/v4/modules/auth/source"
+)
+
+var (
+	_ authsource.Source = &Source{}
+)
+
+// Source implements authsource.Source using a local node
+type Source struct {
+	*local.Source
+	querier authtypes.QueryServer
+	cdc     codec.Codec
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *local.Source, querier authtypes.QueryServer, cdc codec.Codec) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+		cdc:     cdc,
+	}
+}
+
+// GetAccount implements authsource.Source
+func (s Source) GetAccount(address string, height int64) (authtypes.AccountI, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.Account(sdk.WrapSDKContext(ctx), &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account: %s", err)
+	}
+
+	var account authtypes.AccountI
+	err = s.cdc.UnpackAny(res.Account, &account)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking account: %s", err)
+	}
+
+	return account, nil
+}
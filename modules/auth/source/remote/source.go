@@ -0,0 +1,50 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/forbole/juno/v5/node/remote"
+
+	authsource "github.com/forbole/callisto/v4/modules/auth/source"
+)
+
+var (
+	_ authsource.Source = &Source{}
+)
+
+// Source implements authsource.Source using a remote node
+type Source struct {
+	*remote.Source
+	querier authtypes.QueryClient
+	cdc     codec.Codec
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, querier authtypes.QueryClient, cdc codec.Codec) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+		cdc:     cdc,
+	}
+}
+
+// GetAccount implements authsource.Source
+func (s Source) GetAccount(address string, height int64) (authtypes.AccountI, error) {
+	res, err := s.querier.Account(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&authtypes.QueryAccountRequest{Address: address},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account: %s", err)
+	}
+
+	var account authtypes.AccountI
+	err = s.cdc.UnpackAny(res.Account, &account)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking account: %s", err)
+	}
+
+	return account, nil
+}
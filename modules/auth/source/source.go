@@ -0,0 +1,9 @@
+package source
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type Source interface {
+	GetAccount(address string, height int64) (authtypes.AccountI, error)
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// GetVestingUnlocks returns the unlocks of the given vesting account sorted by time.
+// Continuous vesting accounts unlock linearly, so they are represented by a single unlock spanning the whole
+// vesting period. Permanent locked accounts never unlock, so no unlock is returned for them
+func GetVestingUnlocks(account exported.VestingAccount) []types.VestingUnlock {
+	switch vestingAccount := account.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		return []types.VestingUnlock{types.NewVestingUnlock(
+			time.Unix(vestingAccount.StartTime, 0).UTC(),
+			time.Unix(vestingAccount.EndTime, 0).UTC(),
+			vestingAccount.OriginalVesting,
+		)}
+
+	case *vestingtypes.DelayedVestingAccount:
+		endTime := time.Unix(vestingAccount.EndTime, 0).UTC()
+		return []types.VestingUnlock{types.NewVestingUnlock(endTime, endTime, vestingAccount.OriginalVesting)}
+
+	case *vestingtypes.PeriodicVestingAccount:
+		unlocks := make([]types.VestingUnlock, len(vestingAccount.VestingPeriods))
+		periodEnd := vestingAccount.StartTime
+		for i, period := range vestingAccount.VestingPeriods {
+			periodEnd += period.Length
+			unlockTime := time.Unix(periodEnd, 0).UTC()
+			unlocks[i] = types.NewVestingUnlock(unlockTime, unlockTime, period.Amount)
+		}
+		return unlocks
+
+	default:
+		return nil
+	}
+}
+
+// GetVestingAmounts returns the vested, locked and spendable amounts of the given vesting account at the given time.
+// The spendable amount is computed from the given account balance, which does not include the delegated coins,
+// so the locked coins that have been delegated are not subtracted from it
+func GetVestingAmounts(
+	account exported.VestingAccount, balance sdk.Coins, delegatedVesting sdk.Coins, timestamp time.Time,
+) types.VestingAmounts {
+	locked := account.GetVestingCoins(timestamp)
+
+	// The delegated vesting coins are not part of the balance, so only the remaining locked coins are unspendable
+	lockedBalance := sdk.NewCoins()
+	for _, coin := range locked {
+		amount := coin.Amount.Sub(delegatedVesting.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			lockedBalance = lockedBalance.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	spendable := sdk.NewCoins()
+	for _, coin := range balance {
+		amount := coin.Amount.Sub(lockedBalance.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			spendable = spendable.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return types.NewVestingAmounts(
+		timestamp,
+		account.GetOriginalVesting(),
+		account.GetVestedCoins(timestamp),
+		locked,
+		spendable,
+	)
+}
+
+// GetVestingUnlockCalendar returns the amounts that the given vesting accounts will unlock during each UTC day,
+// starting from the day of the given time and for the given number of days.
+// The amount of the first day only includes what is unlocked after the given time, and days during which
+// nothing is unlocked are not returned
+func GetVestingUnlockCalendar(
+	accounts []exported.VestingAccount, from time.Time, days int, height int64,
+) []types.VestingUnlockCalendarEntry {
+	from = from.UTC()
+	firstDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	amounts := make([]sdk.Coins, days)
+	accountsCount := make([]int, days)
+	for _, account := range accounts {
+		// Permanent locked accounts never unlock
+		if _, ok := account.(*vestingtypes.PermanentLockedAccount); ok {
+			continue
+		}
+
+		endTime := time.Unix(account.GetEndTime(), 0)
+		if !endTime.After(from) {
+			continue
+		}
+
+		previous := account.GetVestedCoins(from)
+		for day := 0; day < days; day++ {
+			dayEnd := firstDay.AddDate(0, 0, day+1)
+
+			// Vesting is computed using seconds, so the last second of the day includes all its unlocks
+			vested := account.GetVestedCoins(dayEnd.Add(-time.Second))
+			unlocked := vested.Sub(previous...)
+			if !unlocked.IsZero() {
+				amounts[day] = amounts[day].Add(unlocked...)
+				accountsCount[day]++
+			}
+			previous = vested
+
+			if dayEnd.After(endTime) {
+				break
+			}
+		}
+	}
+
+	var calendar []types.VestingUnlockCalendarEntry
+	for day, amount := range amounts {
+		if amount.IsZero() {
+			continue
+		}
+
+		calendar = append(calendar, types.NewVestingUnlockCalendarEntry(
+			firstDay.AddDate(0, 0, day),
+			amount,
+			accountsCount[day],
+			height,
+		))
+	}
+
+	return calendar
+}
@@ -0,0 +1,110 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/auth"
+)
+
+var (
+	vestingStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	vestingEnd   = vestingStart.AddDate(0, 0, 4)
+)
+
+func newCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("udaric", amount))
+}
+
+func newBaseAccount() *authtypes.BaseAccount {
+	return &authtypes.BaseAccount{Address: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"}
+}
+
+func TestGetVestingUnlocks(t *testing.T) {
+	continuous := vestingtypes.NewContinuousVestingAccount(
+		newBaseAccount(), newCoins(400), vestingStart.Unix(), vestingEnd.Unix(),
+	)
+	unlocks := auth.GetVestingUnlocks(continuous)
+	require.Len(t, unlocks, 1)
+	require.Equal(t, vestingStart, unlocks[0].StartTime)
+	require.Equal(t, vestingEnd, unlocks[0].EndTime)
+	require.Equal(t, newCoins(400), unlocks[0].Amount)
+
+	periodic := vestingtypes.NewPeriodicVestingAccount(
+		newBaseAccount(), newCoins(400), vestingStart.Unix(), vestingtypes.Periods{
+			{Length: 3600, Amount: newCoins(100)},
+			{Length: 7200, Amount: newCoins(300)},
+		},
+	)
+	unlocks = auth.GetVestingUnlocks(periodic)
+	require.Len(t, unlocks, 2)
+	require.Equal(t, vestingStart.Add(time.Hour), unlocks[0].EndTime)
+	require.Equal(t, vestingStart.Add(3*time.Hour), unlocks[1].StartTime)
+	require.Equal(t, newCoins(300), unlocks[1].Amount)
+
+	permanent := vestingtypes.NewPermanentLockedAccount(newBaseAccount(), newCoins(400))
+	require.Empty(t, auth.GetVestingUnlocks(permanent))
+}
+
+func TestGetVestingAmounts(t *testing.T) {
+	account := vestingtypes.NewContinuousVestingAccount(
+		newBaseAccount(), newCoins(400), vestingStart.Unix(), vestingEnd.Unix(),
+	)
+
+	amounts := auth.GetVestingAmounts(account, newCoins(350), nil, vestingStart.AddDate(0, 0, 1))
+	require.Equal(t, newCoins(400), amounts.OriginalVesting)
+	require.Equal(t, newCoins(100), amounts.Vested)
+	require.Equal(t, newCoins(300), amounts.Locked)
+	require.Equal(t, newCoins(50), amounts.Spendable)
+
+	// The spendable amount never goes below zero
+	amounts = auth.GetVestingAmounts(account, newCoins(200), nil, vestingStart.AddDate(0, 0, 1))
+	require.True(t, amounts.Spendable.IsZero())
+
+	// The delegated vesting coins are not part of the balance, so they are not subtracted from it
+	amounts = auth.GetVestingAmounts(account, newCoins(200), newCoins(250), vestingStart.AddDate(0, 0, 1))
+	require.Equal(t, newCoins(300), amounts.Locked)
+	require.Equal(t, newCoins(150), amounts.Spendable)
+}
+
+func TestGetVestingUnlockCalendar(t *testing.T) {
+	accounts := []exported.VestingAccount{
+		vestingtypes.NewContinuousVestingAccount(
+			newBaseAccount(), newCoins(400), vestingStart.Unix(), vestingEnd.Unix(),
+		),
+		vestingtypes.NewDelayedVestingAccount(newBaseAccount(), newCoins(1000), vestingEnd.Unix()),
+		vestingtypes.NewPeriodicVestingAccount(
+			newBaseAccount(), newCoins(300), vestingStart.Unix(), vestingtypes.Periods{
+				{Length: 18 * 3600, Amount: newCoins(100)},
+				{Length: 24 * 3600, Amount: newCoins(200)},
+			},
+		),
+		vestingtypes.NewPermanentLockedAccount(newBaseAccount(), newCoins(5000)),
+	}
+
+	// Start from the middle of the first day, so that only half of its continuous unlocks are included
+	calendar := auth.GetVestingUnlockCalendar(accounts, vestingStart.Add(12*time.Hour), 10, 100)
+	require.Len(t, calendar, 5)
+
+	require.Equal(t, vestingStart, calendar[0].Date)
+	require.Equal(t, newCoins(50+100), calendar[0].Amount)
+	require.Equal(t, 2, calendar[0].AccountsCount)
+	require.Equal(t, int64(100), calendar[0].Height)
+
+	require.Equal(t, vestingStart.AddDate(0, 0, 1), calendar[1].Date)
+	require.Equal(t, newCoins(100+200), calendar[1].Amount)
+
+	require.Equal(t, newCoins(100), calendar[2].Amount)
+	require.Equal(t, newCoins(100), calendar[3].Amount)
+
+	// The delayed account unlocks at midnight, so its amount is part of the day that starts at its end time
+	require.Equal(t, vestingEnd, calendar[4].Date)
+	require.Equal(t, newCoins(1000), calendar[4].Amount)
+	require.Equal(t, 1, calendar[4].AccountsCount)
+}
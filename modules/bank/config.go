@@ -0,0 +1,40 @@
+package bank
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the bank module
+type Config struct {
+	// ExcludedAddresses contains the addresses whose balances should not be considered part of the circulating supply
+	// (eg. treasury or foundation wallets)
+	ExcludedAddresses []string `yaml:"excluded_addresses"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(excludedAddresses []string) *Config {
+	return &Config{
+		ExcludedAddresses: excludedAddresses,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return &Config{
+		ExcludedAddresses: nil,
+	}
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"bank"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+
+	if cfg.Config == nil {
+		return DefaultConfig(), err
+	}
+
+	return cfg.Config, err
+}
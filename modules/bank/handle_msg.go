@@ -0,0 +1,32 @@
+package bank
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	juno "github.com/forbole/juno/v5/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// HandleMsgExec implements modules.AuthzMessageModule
+func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg sdk.Msg, tx *juno.Tx) error {
+	return m.HandleMsg(index, executedMsg, tx)
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
+
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	if _, ok := msg.(*banktypes.MsgUpdateParams); ok {
+		return m.UpdateParams(tx.Height)
+	}
+	return nil
+}
@@ -22,7 +22,7 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 	return nil
 }
 
-// UpdateSupply updates the supply of all the tokens
+// UpdateSupply updates the total and circulating supply of all the tokens
 func (m *Module) UpdateSupply() error {
 	log.Trace().Str("module", "bank").Str("operation", "total supply").
 		Msg("updating total supply")
@@ -37,5 +37,10 @@ func (m *Module) UpdateSupply() error {
 		return err
 	}
 
-	return m.db.SaveSupply(supply, block.Height)
+	err = m.db.SaveSupply(supply, block.Height)
+	if err != nil {
+		return err
+	}
+
+	return m.updateCirculatingSupply(supply, block)
 }
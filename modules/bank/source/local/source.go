@@ -96,3 +96,18 @@ func (s Source) GetAccountBalance(address string, height int64) ([]sdk.Coin, err
 
 	return balRes.Balances, nil
 }
+
+// GetParams implements bankkeeper.Source
+func (s Source) GetParams(height int64) (banktypes.Params, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return banktypes.Params{}, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.q.Params(sdk.WrapSDKContext(ctx), &banktypes.QueryParamsRequest{})
+	if err != nil {
+		return banktypes.Params{}, fmt.Errorf("error while getting params: %s", err)
+	}
+
+	return res.Params, nil
+}
@@ -77,3 +77,13 @@ func (s Source) GetSupply(height int64) (sdk.Coins, error) {
 
 	return coins, nil
 }
+
+// GetParams implements bankkeeper.Source
+func (s Source) GetParams(height int64) (banktypes.Params, error) {
+	res, err := s.bankClient.Params(remote.GetHeightRequestContext(s.Ctx, height), &banktypes.QueryParamsRequest{})
+	if err != nil {
+		return banktypes.Params{}, fmt.Errorf("error while getting params: %s", err)
+	}
+
+	return res.Params, nil
+}
@@ -0,0 +1,77 @@
+package bank
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// updateCirculatingSupply computes the circulating supply at the given block starting from the given total supply,
+// and stores it inside the database
+func (m *Module) updateCirculatingSupply(supply sdk.Coins, block dbtypes.BlockHeightAndTimestamp) error {
+	vestingAccounts, err := m.db.GetVestingAccounts()
+	if err != nil {
+		return err
+	}
+
+	communityPool, err := m.db.GetCommunityPool()
+	if err != nil {
+		return err
+	}
+
+	var excludedBalances []types.AccountBalance
+	if len(m.cfg.ExcludedAddresses) > 0 {
+		excludedBalances, err = m.keeper.GetBalances(m.cfg.ExcludedAddresses, block.Height)
+		if err != nil {
+			return fmt.Errorf("error while getting excluded addresses balances: %s", err)
+		}
+	}
+
+	circulating := GetCirculatingSupply(supply, vestingAccounts, communityPool, excludedBalances, block.BlockTimestamp)
+	return m.db.SaveCirculatingSupply(circulating, block.Height)
+}
+
+// GetCirculatingSupply returns the circulating supply at the given time. This is computed by removing from the
+// given total supply the amounts that are still locked inside the vesting accounts, the community pool and
+// the balances of the excluded addresses. Denoms that have no circulating amount are not included.
+func GetCirculatingSupply(
+	supply sdk.Coins,
+	vestingAccounts []exported.VestingAccount,
+	communityPool sdk.DecCoins,
+	excludedBalances []types.AccountBalance,
+	timestamp time.Time,
+) sdk.Coins {
+	nonCirculating := sdk.NewCoins()
+
+	// The whole balance of excluded addresses is removed, so their vesting amounts must not be counted twice
+	excluded := make(map[string]bool, len(excludedBalances))
+	for _, balance := range excludedBalances {
+		excluded[balance.Address] = true
+		nonCirculating = nonCirculating.Add(balance.Balance...)
+	}
+
+	for _, account := range vestingAccounts {
+		if excluded[account.GetAddress().String()] {
+			continue
+		}
+		nonCirculating = nonCirculating.Add(account.GetVestingCoins(timestamp)...)
+	}
+
+	communityPoolCoins, _ := communityPool.TruncateDecimal()
+	nonCirculating = nonCirculating.Add(communityPoolCoins...)
+
+	circulating := sdk.NewCoins()
+	for _, coin := range supply {
+		amount := coin.Amount.Sub(nonCirculating.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			circulating = circulating.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return circulating
+}
@@ -0,0 +1,76 @@
+package bank_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/bank"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestGetCirculatingSupply(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Hour)
+
+	continuous := vestingtypes.NewContinuousVestingAccount(
+		&authtypes.BaseAccount{Address: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"},
+		sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)),
+		start.Unix(),
+		end.Unix(),
+	)
+	delayed := vestingtypes.NewDelayedVestingAccount(
+		&authtypes.BaseAccount{Address: "cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7"},
+		sdk.NewCoins(sdk.NewInt64Coin("udaric", 500)),
+		end.Unix(),
+	)
+
+	supply := sdk.NewCoins(sdk.NewInt64Coin("udaric", 10000), sdk.NewInt64Coin("utoken", 100))
+	vestingAccounts := []exported.VestingAccount{continuous, delayed}
+	communityPool := sdk.NewDecCoins(sdk.NewDecCoinFromDec("udaric", sdk.MustNewDecFromStr("250.75")))
+
+	testCases := []struct {
+		name      string
+		excluded  []types.AccountBalance
+		timestamp time.Time
+		expected  sdk.Coins
+	}{
+		{
+			name:      "before the vesting start everything is locked",
+			timestamp: start,
+			expected:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 8250), sdk.NewInt64Coin("utoken", 100)),
+		},
+		{
+			name:      "half of the continuous vesting is unlocked",
+			timestamp: start.Add(50 * time.Hour),
+			expected:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 8750), sdk.NewInt64Coin("utoken", 100)),
+		},
+		{
+			name:      "after the vesting end everything is unlocked",
+			timestamp: end,
+			expected:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 9750), sdk.NewInt64Coin("utoken", 100)),
+		},
+		{
+			name: "excluded vesting accounts are not counted twice",
+			excluded: []types.AccountBalance{
+				types.NewAccountBalance("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7", sdk.NewCoins(sdk.NewInt64Coin("udaric", 600)), 10),
+				types.NewAccountBalance("cosmos1495ghynrns8sxfnw8mj887pgh0c9z6c4lqkzme", sdk.NewCoins(sdk.NewInt64Coin("utoken", 100)), 10),
+			},
+			timestamp: start,
+			expected:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 8150)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			circulating := bank.GetCirculatingSupply(supply, vestingAccounts, communityPool, tc.excluded, tc.timestamp)
+			require.Equal(t, tc.expected, circulating)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package block_batch
+
+import (
+	"fmt"
+	"time"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	block *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
+	err = m.db.CommitBlockBatch(block.Block.Height)
+	if err != nil {
+		return fmt.Errorf("error while committing block batch: %s", err)
+	}
+
+	return nil
+}
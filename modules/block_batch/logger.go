@@ -0,0 +1,42 @@
+package block_batch
+
+import (
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/forbole/juno/v5/logging"
+	"github.com/forbole/juno/v5/modules"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+var (
+	_ logging.Logger = &Logger{}
+)
+
+// Logger represents the logger failing the block batch of each block that a module fails to handle.
+// Since the errors returned while handling a block are only logged by the parser, it must be used as the
+// parser logger for the block batches to know about them
+type Logger struct {
+	logging.Logger
+	db *database.Db
+}
+
+// NewLogger returns a new Logger instance logging all the messages using the given logger
+func NewLogger(logger logging.Logger) *Logger {
+	return &Logger{
+		Logger: logger,
+	}
+}
+
+// SetDatabase sets the database whose block batches should be failed
+func (l *Logger) SetDatabase(db *database.Db) {
+	l.db = db
+}
+
+// BlockError implements logging.Logger
+func (l *Logger) BlockError(module modules.Module, block *tmctypes.ResultBlock, err error) {
+	l.Logger.BlockError(module, block, err)
+
+	if l.db != nil {
+		l.db.FailBlockBatch(block.Block.Height, err)
+	}
+}
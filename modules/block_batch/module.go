@@ -0,0 +1,60 @@
+package block_batch
+
+import (
+	"fmt"
+
+	"github.com/forbole/juno/v5/logging"
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// ModuleName represents the name of the block batch module
+const ModuleName = "block batch"
+
+var (
+	_ modules.Module      = &Module{}
+	_ modules.BlockModule = &Module{}
+)
+
+// Module represents the module committing atomically each block along with the writes performed by the other
+// modules while handling it. Since the block modules are called in the same order as they are listed inside
+// the configuration, it must be listed after all the other modules. The transactions and messages are handled
+// after the block batch is committed, so their writes are stored immediately instead.
+// If any other module fails to handle a block, its batch is not committed and the block is stored as missing
+type Module struct {
+	db *database.Db
+}
+
+// NewModule builds a new Module instance. If the module is enabled inside the given configuration,
+// the given database starts collecting each stored block along with its writes inside a block batch.
+// In that case the given logger must be a Logger, so that the modules failing to handle a block are known
+func NewModule(cfg config.Config, db *database.Db, logger logging.Logger) *Module {
+	for index, name := range cfg.Chain.Modules {
+		if name != ModuleName {
+			continue
+		}
+
+		if index != len(cfg.Chain.Modules)-1 {
+			panic(fmt.Errorf("%s module must be listed after all the other modules", ModuleName))
+		}
+
+		blockBatchLogger, ok := logger.(*Logger)
+		if !ok {
+			panic(fmt.Errorf("%s module requires the parser to use the block batch logger", ModuleName))
+		}
+
+		blockBatchLogger.SetDatabase(db)
+		db.EnableBlockBatches()
+	}
+
+	return &Module{
+		db: db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return ModuleName
+}
@@ -0,0 +1,12 @@
+package consensus
+
+import (
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+type StakingModule interface {
+	GetValidators(height int64) ([]stakingtypes.Validator, error)
+	GetValidatorsStatuses(height int64, validators []stakingtypes.Validator) ([]types.ValidatorStatus, error)
+}
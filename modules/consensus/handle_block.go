@@ -2,24 +2,46 @@ package consensus
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/forbole/juno/v5/types"
 
 	"github.com/rs/zerolog/log"
 
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleBlock implements modules.Module
 func (m *Module) HandleBlock(
-	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ []*types.Tx, _ *tmctypes.ResultValidators,
-) error {
-	err := m.updateBlockTimeFromGenesis(b)
+	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, txs []*types.Tx, vals *tmctypes.ResultValidators,
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
+	err = m.updateBlockTimeFromGenesis(b)
 	if err != nil {
 		log.Error().Str("module", "consensus").Int64("height", b.Block.Height).
 			Err(err).Msg("error while updating block time from genesis")
 	}
 
+	var totalGas int64
+	for _, tx := range txs {
+		totalGas += tx.GasUsed
+	}
+
+	proposer := sdk.ConsAddress(b.Block.ProposerAddress).String()
+	err = m.UpdateProposerStats(b.Block.Height, b.Block.Time, proposer, int64(len(txs)), totalGas, vals)
+	if err != nil {
+		return fmt.Errorf("error while updating proposer stats: %s", err)
+	}
+
+	err = m.updateValidatorSetChanges(b.Block.Height, b.Block.Time, vals)
+	if err != nil {
+		return fmt.Errorf("error while updating validator set changes: %s", err)
+	}
+
 	return nil
 }
 
@@ -32,12 +54,9 @@ func (m *Module) updateBlockTimeFromGenesis(block *tmctypes.ResultBlock) error {
 	if err != nil {
 		return fmt.Errorf("error while getting genesis: %s", err)
 	}
-	if genesis == nil {
-		return fmt.Errorf("genesis table is empty")
-	}
 
-	// Skip if the genesis does not exist
-	if genesis == nil {
+	// Skip if the genesis does not exist, or if there are no blocks since genesis
+	if genesis == nil || block.Block.Height <= genesis.InitialHeight {
 		return nil
 	}
 
@@ -1,6 +1,12 @@
 package consensus
 
 import (
+	"sync"
+	"sync/atomic"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/forbole/juno/v5/node"
+
 	"github.com/forbole/callisto/v4/database"
 
 	"github.com/forbole/juno/v5/modules"
@@ -15,13 +21,25 @@ var (
 
 // Module implements the consensus utils
 type Module struct {
-	db *database.Db
+	node          node.Node
+	stakingModule StakingModule
+	db            *database.Db
+
+	// validatorSet contains the latest handled validator set, used to avoid
+	// querying the node for the previous validator set when handling consecutive blocks
+	validatorSet      *tmctypes.ResultValidators
+	validatorSetMutex sync.Mutex
+
+	// initialHeight contains the initial height of the chain once it has been read from the genesis
+	initialHeight atomic.Int64
 }
 
 // NewModule builds a new Module instance
-func NewModule(db *database.Db) *Module {
+func NewModule(node node.Node, stakingModule StakingModule, db *database.Db) *Module {
 	return &Module{
-		db: db,
+		node:          node,
+		stakingModule: stakingModule,
+		db:            db,
 	}
 }
 
@@ -0,0 +1,84 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// blockTimeChunk represents the time range of blocks used to compute the block time buckets at once,
+// so that long ranges are computed in smaller steps that can be resumed
+const blockTimeChunk = 24 * time.Hour
+
+// UpdateBlockTimeSeries computes the block time buckets of all the periods starting from the given time.
+// If no time is given, the computation resumes from the latest stored bucket of each period,
+// or starts from the first stored block if no bucket has been stored yet
+func (m *Module) UpdateBlockTimeSeries(since *time.Time) error {
+	log.Trace().Str("module", "consensus").Str("operation", "block time").
+		Msg("updating block time series")
+
+	lastBlock, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no blocks yet
+	if lastBlock.Height == 0 {
+		return nil
+	}
+
+	for _, period := range types.BlockTimePeriods {
+		start, err := m.getBlockTimeSeriesStart(period, since)
+		if err != nil {
+			return err
+		}
+
+		for from := start; !from.After(lastBlock.BlockTimestamp); from = from.Add(blockTimeChunk) {
+			err = m.db.SaveBlockTimeBuckets(period, from, from.Add(blockTimeChunk), lastBlock.Height)
+			if err != nil {
+				return fmt.Errorf("error while updating %s block time buckets: %s", period, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getBlockTimeSeriesStart returns the start time of the first bucket of the given period to be computed
+func (m *Module) getBlockTimeSeriesStart(period string, since *time.Time) (time.Time, error) {
+	if since != nil {
+		return GetBlockTimeBucket(period, *since), nil
+	}
+
+	// Recompute the latest bucket since it might have been computed before it was complete
+	lastBucket, err := m.db.GetLastBlockTimeBucket(period)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if lastBucket != nil {
+		return *lastBucket, nil
+	}
+
+	firstBlock, err := m.db.GetFirstBlockHeightAndTimestamp()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return GetBlockTimeBucket(period, firstBlock.BlockTimestamp), nil
+}
+
+// GetBlockTimeBucket returns the start time of the bucket of the given period containing the given time
+func GetBlockTimeBucket(period string, t time.Time) time.Time {
+	switch period {
+	case types.BlockTimePeriodMinute:
+		return t.UTC().Truncate(time.Minute)
+	case types.BlockTimePeriodHour:
+		return t.UTC().Truncate(time.Hour)
+	default:
+		return t.UTC().Truncate(24 * time.Hour)
+	}
+}
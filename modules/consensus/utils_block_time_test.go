@@ -0,0 +1,28 @@
+package consensus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/consensus"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestGetBlockTimeBucket(t *testing.T) {
+	timestamp := time.Date(2020, 1, 1, 15, 30, 45, 500, time.UTC)
+
+	require.Equal(t,
+		time.Date(2020, 1, 1, 15, 30, 0, 0, time.UTC),
+		consensus.GetBlockTimeBucket(types.BlockTimePeriodMinute, timestamp),
+	)
+	require.Equal(t,
+		time.Date(2020, 1, 1, 15, 0, 0, 0, time.UTC),
+		consensus.GetBlockTimeBucket(types.BlockTimePeriodHour, timestamp),
+	)
+	require.Equal(t,
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		consensus.GetBlockTimeBucket(types.BlockTimePeriodDay, timestamp),
+	)
+}
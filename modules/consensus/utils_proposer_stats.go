@@ -0,0 +1,66 @@
+package consensus
+
+import (
+	"time"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// UpdateProposerStats adds the block having the given data to the daily block production statistics
+// of the validators that were part of the given validator set
+func (m *Module) UpdateProposerStats(
+	height int64, timestamp time.Time, proposer string, txsNum int64, totalGas int64, vals *tmctypes.ResultValidators,
+) error {
+	log.Trace().Str("module", "consensus").Int64("height", height).
+		Msg("updating proposer stats")
+
+	stats := GetProposerBlockStats(height, timestamp, proposer, txsNum, totalGas, vals)
+	return m.db.SaveProposerBlockStats(height, stats)
+}
+
+// GetProposerBlockStats returns the contribution of the block having the given data to the daily statistics
+// of each validator inside the given validator set. The expected proposals of each validator are
+// given by its share of the total voting power, since the proposer priority is proportional to it
+func GetProposerBlockStats(
+	height int64, timestamp time.Time, proposer string, txsNum int64, totalGas int64, vals *tmctypes.ResultValidators,
+) []types.ProposerBlockStats {
+	if vals == nil {
+		return nil
+	}
+
+	var totalVotingPower int64
+	for _, val := range vals.Validators {
+		totalVotingPower += val.VotingPower
+	}
+
+	date := timestamp.UTC().Truncate(24 * time.Hour)
+
+	var stats []types.ProposerBlockStats
+	for _, val := range vals.Validators {
+		consAddr := sdk.ConsAddress(val.Address).String()
+
+		expectedProposals := sdk.ZeroDec()
+		if totalVotingPower > 0 {
+			expectedProposals = sdk.NewDec(val.VotingPower).QuoInt64(totalVotingPower)
+		}
+
+		var blocksProposed, emptyBlocks, gas int64
+		if consAddr == proposer {
+			blocksProposed = 1
+			gas = totalGas
+			if txsNum == 0 {
+				emptyBlocks = 1
+			}
+		}
+
+		stats = append(stats, types.NewProposerBlockStats(
+			consAddr, date, blocksProposed, expectedProposals, emptyBlocks, gas, height,
+		))
+	}
+
+	return stats
+}
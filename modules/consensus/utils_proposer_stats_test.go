@@ -0,0 +1,57 @@
+package consensus_test
+
+import (
+	"testing"
+	"time"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/consensus"
+)
+
+func TestGetProposerBlockStats(t *testing.T) {
+	first := sdk.ConsAddress([]byte("first_validator_address"))
+	second := sdk.ConsAddress([]byte("second_validator_addres"))
+
+	vals := &tmctypes.ResultValidators{
+		Validators: []*tmtypes.Validator{
+			{Address: first.Bytes(), VotingPower: 75},
+			{Address: second.Bytes(), VotingPower: 25},
+		},
+	}
+	timestamp := time.Date(2020, 1, 1, 15, 30, 0, 0, time.UTC)
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("block with transactions", func(t *testing.T) {
+		stats := consensus.GetProposerBlockStats(10, timestamp, second.String(), 2, 1000, vals)
+		require.Len(t, stats, 2)
+
+		require.Equal(t, first.String(), stats[0].ValidatorAddress)
+		require.Equal(t, date, stats[0].Date)
+		require.Equal(t, int64(0), stats[0].BlocksProposed)
+		require.Equal(t, sdk.NewDecWithPrec(75, 2), stats[0].ExpectedProposals)
+		require.Equal(t, int64(0), stats[0].TotalGas)
+
+		require.Equal(t, second.String(), stats[1].ValidatorAddress)
+		require.Equal(t, int64(1), stats[1].BlocksProposed)
+		require.Equal(t, sdk.NewDecWithPrec(25, 2), stats[1].ExpectedProposals)
+		require.Equal(t, int64(0), stats[1].EmptyBlocks)
+		require.Equal(t, int64(1000), stats[1].TotalGas)
+		require.Equal(t, int64(10), stats[1].Height)
+	})
+
+	t.Run("empty block", func(t *testing.T) {
+		stats := consensus.GetProposerBlockStats(10, timestamp, first.String(), 0, 0, vals)
+		require.Len(t, stats, 2)
+		require.Equal(t, int64(1), stats[0].BlocksProposed)
+		require.Equal(t, int64(1), stats[0].EmptyBlocks)
+		require.Equal(t, int64(0), stats[1].EmptyBlocks)
+	})
+
+	t.Run("nil validator set", func(t *testing.T) {
+		require.Empty(t, consensus.GetProposerBlockStats(10, timestamp, first.String(), 0, 0, nil))
+	})
+}
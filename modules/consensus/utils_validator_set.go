@@ -0,0 +1,176 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// defaultInitialHeight represents the initial height of the chains whose genesis does not specify one
+const defaultInitialHeight = 1
+
+// updateValidatorSetChanges compares the given validator set with the one of the previous height,
+// and stores the validators that have entered or left the active set, or whose voting power has changed
+func (m *Module) updateValidatorSetChanges(height int64, timestamp time.Time, vals *tmctypes.ResultValidators) error {
+	log.Trace().Str("module", "consensus").Int64("height", height).
+		Msg("updating validator set changes")
+
+	if vals == nil {
+		return nil
+	}
+
+	// Skip the first block since there is no previous validator set to compare with
+	initialHeight, err := m.getInitialHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting initial height: %s", err)
+	}
+
+	if height <= initialHeight {
+		m.setValidatorSet(height, vals)
+		return nil
+	}
+
+	previous, err := m.getPreviousValidatorSet(height)
+	if err != nil {
+		return fmt.Errorf("error while getting previous validator set: %s", err)
+	}
+	m.setValidatorSet(height, vals)
+
+	changes := GetValidatorSetChanges(previous.Validators, vals.Validators, height, timestamp)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// Add the staking status of the changed validators, reusing the validators fetched by the staking module
+	stakingValidators, err := m.stakingModule.GetValidators(height)
+	if err != nil {
+		return fmt.Errorf("error while getting staking validators: %s", err)
+	}
+
+	statuses, err := m.stakingModule.GetValidatorsStatuses(height, stakingValidators)
+	if err != nil {
+		return fmt.Errorf("error while getting validators statuses: %s", err)
+	}
+
+	statusesMap := make(map[string]types.ValidatorStatus, len(statuses))
+	for _, status := range statuses {
+		statusesMap[status.ConsensusAddress] = status
+	}
+
+	for i, change := range changes {
+		if status, ok := statusesMap[change.ValidatorAddress]; ok {
+			changes[i].Status = status.Status
+			changes[i].Jailed = status.Jailed
+		}
+	}
+
+	return m.db.SaveValidatorSetChanges(changes)
+}
+
+// getInitialHeight returns the initial height of the chain, as stored inside the genesis.
+// If the genesis has not been stored yet, the default initial height is returned
+func (m *Module) getInitialHeight() (int64, error) {
+	if initialHeight := m.initialHeight.Load(); initialHeight > 0 {
+		return initialHeight, nil
+	}
+
+	genesis, err := m.db.GetGenesis()
+	if err != nil {
+		return 0, err
+	}
+
+	if genesis == nil {
+		return defaultInitialHeight, nil
+	}
+
+	initialHeight := genesis.InitialHeight
+	if initialHeight <= 0 {
+		initialHeight = defaultInitialHeight
+	}
+
+	m.initialHeight.Store(initialHeight)
+	return initialHeight, nil
+}
+
+// getPreviousValidatorSet returns the validator set of the height preceding the given one,
+// using the latest handled validator set when possible
+func (m *Module) getPreviousValidatorSet(height int64) (*tmctypes.ResultValidators, error) {
+	m.validatorSetMutex.Lock()
+	cached := m.validatorSet
+	m.validatorSetMutex.Unlock()
+
+	if cached != nil && cached.BlockHeight == height-1 {
+		return cached, nil
+	}
+
+	return m.node.Validators(height - 1)
+}
+
+// setValidatorSet stores the given validator set as the latest handled one,
+// unless a validator set of a higher height has already been handled
+func (m *Module) setValidatorSet(height int64, vals *tmctypes.ResultValidators) {
+	m.validatorSetMutex.Lock()
+	defer m.validatorSetMutex.Unlock()
+
+	if m.validatorSet != nil && m.validatorSet.BlockHeight > height {
+		return
+	}
+
+	m.validatorSet = &tmctypes.ResultValidators{
+		BlockHeight: height,
+		Validators:  vals.Validators,
+		Count:       vals.Count,
+		Total:       vals.Total,
+	}
+}
+
+// GetValidatorSetChanges returns the changes between the given previous and current validator sets.
+// The staking status of the returned changes is left unspecified
+func GetValidatorSetChanges(
+	previous, current []*tmtypes.Validator, height int64, timestamp time.Time,
+) []types.ValidatorSetChange {
+	previousPowers := make(map[string]int64, len(previous))
+	for _, val := range previous {
+		previousPowers[sdk.ConsAddress(val.Address).String()] = val.VotingPower
+	}
+
+	currentPowers := make(map[string]int64, len(current))
+	for _, val := range current {
+		currentPowers[sdk.ConsAddress(val.Address).String()] = val.VotingPower
+	}
+
+	var changes []types.ValidatorSetChange
+	for _, val := range current {
+		consAddr := sdk.ConsAddress(val.Address).String()
+
+		previousPower, found := previousPowers[consAddr]
+		switch {
+		case !found:
+			changes = append(changes, types.NewValidatorSetChange(
+				consAddr, types.ValidatorSetChangeEntered, 0, val.VotingPower, 0, false, height, timestamp,
+			))
+
+		case previousPower != val.VotingPower:
+			changes = append(changes, types.NewValidatorSetChange(
+				consAddr, types.ValidatorSetChangeVotingPower, previousPower, val.VotingPower, 0, false, height, timestamp,
+			))
+		}
+	}
+
+	for _, val := range previous {
+		consAddr := sdk.ConsAddress(val.Address).String()
+		if _, found := currentPowers[consAddr]; !found {
+			changes = append(changes, types.NewValidatorSetChange(
+				consAddr, types.ValidatorSetChangeLeft, val.VotingPower, 0, 0, false, height, timestamp,
+			))
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,46 @@
+package consensus_test
+
+import (
+	"testing"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/consensus"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestGetValidatorSetChanges(t *testing.T) {
+	first := sdk.ConsAddress([]byte("first_validator_address"))
+	second := sdk.ConsAddress([]byte("second_validator_addres"))
+	third := sdk.ConsAddress([]byte("third_validator_address"))
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	previous := []*tmtypes.Validator{
+		{Address: first.Bytes(), VotingPower: 10},
+		{Address: second.Bytes(), VotingPower: 20},
+	}
+
+	t.Run("unchanged validator set", func(t *testing.T) {
+		require.Empty(t, consensus.GetValidatorSetChanges(previous, previous, 10, timestamp))
+	})
+
+	t.Run("changed validator set", func(t *testing.T) {
+		current := []*tmtypes.Validator{
+			{Address: first.Bytes(), VotingPower: 15},
+			{Address: third.Bytes(), VotingPower: 5},
+		}
+
+		changes := consensus.GetValidatorSetChanges(previous, current, 10, timestamp)
+		require.Equal(t, []types.ValidatorSetChange{
+			types.NewValidatorSetChange(first.String(), types.ValidatorSetChangeVotingPower, 10, 15, 0, false, 10, timestamp),
+			types.NewValidatorSetChange(third.String(), types.ValidatorSetChangeEntered, 0, 5, 0, false, 10, timestamp),
+			types.NewValidatorSetChange(second.String(), types.ValidatorSetChangeLeft, 20, 0, 0, false, 10, timestamp),
+		}, changes)
+
+		require.Equal(t, int64(5), changes[0].VotingPowerDelta())
+		require.Equal(t, int64(-20), changes[2].VotingPowerDelta())
+	})
+}
@@ -0,0 +1,84 @@
+package daily_refetch
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the daily refetch module
+type Config struct {
+	// Interval represents how often the missing blocks are detected and refetched
+	Interval time.Duration `yaml:"interval"`
+
+	// ChunkSize represents the number of heights that are scanned at once while detecting the missing blocks
+	ChunkSize int64 `yaml:"chunk_size"`
+
+	// Concurrency represents the number of missing blocks that are refetched at the same time
+	Concurrency int `yaml:"concurrency"`
+
+	// BatchSize represents the number of missing blocks that are read from the database at once
+	BatchSize int `yaml:"batch_size"`
+
+	// RetryBackoff represents the time waited before refetching a missing block the first time.
+	// It is doubled after each failed attempt, up to MaxRetryBackoff
+	RetryBackoff    time.Duration `yaml:"retry_backoff"`
+	MaxRetryBackoff time.Duration `yaml:"max_retry_backoff"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(
+	interval time.Duration, chunkSize int64, concurrency int, batchSize int, retryBackoff, maxRetryBackoff time.Duration,
+) *Config {
+	return &Config{
+		Interval:        interval,
+		ChunkSize:       chunkSize,
+		Concurrency:     concurrency,
+		BatchSize:       batchSize,
+		RetryBackoff:    retryBackoff,
+		MaxRetryBackoff: maxRetryBackoff,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(10*time.Minute, 10000, 4, 100, time.Minute, 24*time.Hour)
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"daily_refetch"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	// Use the default values for the missing fields
+	defaultCfg := DefaultConfig()
+	if cfg.Config.Interval <= 0 {
+		cfg.Config.Interval = defaultCfg.Interval
+	}
+	if cfg.Config.ChunkSize <= 0 {
+		cfg.Config.ChunkSize = defaultCfg.ChunkSize
+	}
+	if cfg.Config.Concurrency <= 0 {
+		cfg.Config.Concurrency = defaultCfg.Concurrency
+	}
+	if cfg.Config.BatchSize <= 0 {
+		cfg.Config.BatchSize = defaultCfg.BatchSize
+	}
+	if cfg.Config.RetryBackoff <= 0 {
+		cfg.Config.RetryBackoff = defaultCfg.RetryBackoff
+	}
+	if cfg.Config.MaxRetryBackoff <= 0 {
+		cfg.Config.MaxRetryBackoff = defaultCfg.MaxRetryBackoff
+	}
+
+	return cfg.Config, nil
+}
@@ -1,7 +1,11 @@
 package daily_refetch
 
 import (
+	"github.com/forbole/juno/v5/logging"
 	"github.com/forbole/juno/v5/node"
+	"github.com/forbole/juno/v5/parser"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/forbole/juno/v5/types/params"
 
 	callistodb "github.com/forbole/callisto/v4/database"
 
@@ -14,21 +18,58 @@ var (
 )
 
 type Module struct {
-	node     node.Node
-	database *callistodb.Db
+	cfg            *Config
+	modulesNames   []string
+	encodingConfig params.EncodingConfig
+	node           node.Node
+	database       *callistodb.Db
+	logger         logging.Logger
+
+	// parserCtx is the context used to refetch the missing blocks, set once all the modules have been built
+	parserCtx *parser.Context
 }
 
 // NewModule builds a new Module instance
 func NewModule(
+	cfg config.Config,
+	encodingConfig params.EncodingConfig,
 	node node.Node,
 	database *callistodb.Db,
+	logger logging.Logger,
 ) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	refetchCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
-		node:     node,
-		database: database,
+		cfg:            refetchCfg,
+		modulesNames:   cfg.Chain.Modules,
+		encodingConfig: encodingConfig,
+		node:           node,
+		database:       database,
+		logger:         logger,
 	}
 }
 
+// SetModules sets the modules that should handle the refetched blocks.
+// Only the given modules that are enabled inside the configuration are used
+func (m *Module) SetModules(mods modules.Modules) {
+	var enabledModules []modules.Module
+	for _, name := range m.modulesNames {
+		if module, found := mods.FindByName(name); found {
+			enabledModules = append(enabledModules, module)
+		}
+	}
+
+	m.parserCtx = parser.NewContext(m.encodingConfig, m.node, m.database, m.logger, enabledModules)
+}
+
 // Name implements modules.Module
 func (m *Module) Name() string {
 	return "daily refetch"
@@ -0,0 +1,61 @@
+package daily_refetch
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MissingBlocksGauge represents the Telemetry gauge used to track the number of missing blocks waiting to be refetched
+var MissingBlocksGauge = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "callisto_missing_blocks",
+		Help: "Number of missing blocks waiting to be refetched.",
+	},
+)
+
+// LastScannedHeightGauge represents the Telemetry gauge used to track the height up to which
+// the stored blocks have been scanned for missing ones
+var LastScannedHeightGauge = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "callisto_gap_detector_last_scanned_height",
+		Help: "Height up to which the stored blocks have been scanned for missing ones.",
+	},
+)
+
+// DetectedBlocksCounter represents the Telemetry counter used to track the total number of missing blocks detected
+var DetectedBlocksCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "callisto_missing_blocks_detected_total",
+		Help: "Total number of missing blocks detected.",
+	},
+)
+
+// RefetchedBlocksCounter represents the Telemetry counter used to track the total number of missing blocks refetched
+var RefetchedBlocksCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "callisto_missing_blocks_refetched_total",
+		Help: "Total number of missing blocks refetched.",
+	},
+)
+
+// RefetchErrorsCounter represents the Telemetry counter used to track the total number of failed refetch attempts
+var RefetchErrorsCounter = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Name: "callisto_missing_blocks_refetch_errors_total",
+		Help: "Total number of failed missing block refetch attempts.",
+	},
+)
+
+func init() {
+	for _, collector := range []prometheus.Collector{
+		MissingBlocksGauge,
+		LastScannedHeightGauge,
+		DetectedBlocksCounter,
+		RefetchedBlocksCounter,
+		RefetchErrorsCounter,
+	} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
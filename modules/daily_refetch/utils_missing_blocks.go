@@ -0,0 +1,181 @@
+package daily_refetch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/forbole/juno/v5/parser"
+	"github.com/rs/zerolog/log"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+)
+
+// DetectMissingBlocks scans the stored blocks in chunks, starting from the latest scanned height or from the
+// first stored block, and stores the missing heights so that they can be refetched
+func (m *Module) DetectMissingBlocks() error {
+	log.Trace().Str("module", "daily refetch").Str("operation", "detect").
+		Msg("detecting missing blocks")
+
+	lastBlock, err := m.database.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no blocks yet
+	if lastBlock.Height == 0 {
+		return nil
+	}
+
+	lastScannedHeight, err := m.database.GetGapDetectorLastScannedHeight()
+	if err != nil {
+		return err
+	}
+
+	startHeight := lastScannedHeight + 1
+	if lastScannedHeight == 0 {
+		firstBlock, err := m.database.GetFirstBlockHeightAndTimestamp()
+		if err != nil {
+			return err
+		}
+		startHeight = firstBlock.Height
+	}
+
+	for from := startHeight; from <= lastBlock.Height; from += m.cfg.ChunkSize {
+		to := from + m.cfg.ChunkSize - 1
+		if to > lastBlock.Height {
+			to = lastBlock.Height
+		}
+
+		missingBlocks, err := m.database.GetMissingBlocks(from, to)
+		if err != nil {
+			return err
+		}
+
+		// Delay the first attempt so that the blocks that are being parsed right now are not refetched
+		now := time.Now().UTC()
+		err = m.database.SaveMissingBlocks(missingBlocks, now, now.Add(m.cfg.RetryBackoff))
+		if err != nil {
+			return err
+		}
+
+		err = m.database.SaveGapDetectorLastScannedHeight(to)
+		if err != nil {
+			return err
+		}
+
+		if len(missingBlocks) > 0 {
+			log.Info().Str("module", "daily refetch").Int64("start height", from).Int64("end height", to).
+				Int("missing blocks", len(missingBlocks)).Msg("found missing blocks")
+		}
+
+		DetectedBlocksCounter.Add(float64(len(missingBlocks)))
+		LastScannedHeightGauge.Set(float64(to))
+	}
+
+	return m.updateMissingBlocksGauge()
+}
+
+// RefetchMissingBlocks refetches all the missing blocks whose next attempt is due, using the configured
+// concurrency. Blocks that fail to be refetched are retried later with an exponential backoff
+func (m *Module) RefetchMissingBlocks() error {
+	log.Trace().Str("module", "daily refetch").Str("operation", "refetch").
+		Msg("refetching missing blocks")
+
+	if m.parserCtx == nil {
+		return fmt.Errorf("daily refetch modules not set")
+	}
+
+	for {
+		missingBlocks, err := m.database.GetDueMissingBlocks(time.Now().UTC(), m.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(missingBlocks) == 0 {
+			break
+		}
+
+		jobs := make(chan dbtypes.MissingBlockRow)
+		var wg sync.WaitGroup
+		for i := 0; i < m.cfg.Concurrency; i++ {
+			worker := parser.NewWorker(m.parserCtx, nil, i)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for missingBlock := range jobs {
+					err := m.refetchMissingBlock(worker, missingBlock)
+					if err != nil {
+						log.Error().Str("module", "daily refetch").Int64("height", missingBlock.Height).
+							Err(err).Msg("error while updating missing block")
+					}
+				}
+			}()
+		}
+
+		for _, missingBlock := range missingBlocks {
+			jobs <- missingBlock
+		}
+		close(jobs)
+		wg.Wait()
+	}
+
+	return m.updateMissingBlocksGauge()
+}
+
+// refetchMissingBlock refetches the given missing block using the given worker, scheduling
+// its next attempt if it fails.
+// The block is processed even if it is already stored, since it might have been stored without the writes
+// of its modules. If that happens again while refetching it, the missing block is not removed
+func (m *Module) refetchMissingBlock(worker parser.Worker, missingBlock dbtypes.MissingBlockRow) error {
+	err := worker.Process(missingBlock.Height)
+	if err == nil {
+		var deleted bool
+		deleted, err = m.database.DeleteMissingBlock(missingBlock.Height, missingBlock.DetectedAt)
+		if err != nil {
+			return err
+		}
+
+		if deleted {
+			RefetchedBlocksCounter.Inc()
+			return nil
+		}
+
+		err = fmt.Errorf("block stored without the writes of its modules")
+	}
+
+	RefetchErrorsCounter.Inc()
+	log.Error().Str("module", "daily refetch").Int64("height", missingBlock.Height).
+		Int("retry count", missingBlock.RetryCount).Err(err).Msg("error while refetching missing block")
+
+	backoff := GetRetryBackoff(missingBlock.RetryCount, m.cfg.RetryBackoff, m.cfg.MaxRetryBackoff)
+	return m.database.SaveMissingBlockFailure(missingBlock.Height, err, time.Now().UTC().Add(backoff))
+}
+
+// updateMissingBlocksGauge sets the missing blocks gauge to the number of missing blocks stored inside the database
+func (m *Module) updateMissingBlocksGauge() error {
+	count, err := m.database.CountMissingBlocks()
+	if err != nil {
+		return err
+	}
+
+	MissingBlocksGauge.Set(float64(count))
+	return nil
+}
+
+// GetRetryBackoff returns the time to wait before the next attempt to refetch a block that has already
+// failed the given number of times. The given backoff is doubled after each failure, up to the given max backoff
+func GetRetryBackoff(retryCount int, backoff, maxBackoff time.Duration) time.Duration {
+	for i := 0; i < retryCount; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,49 @@
+package daily_refetch_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dailyrefetch "github.com/forbole/callisto/v4/modules/daily_refetch"
+)
+
+func TestGetRetryBackoff(t *testing.T) {
+	testCases := []struct {
+		name       string
+		retryCount int
+		expected   time.Duration
+	}{
+		{name: "first attempt uses the base backoff", retryCount: 0, expected: time.Minute},
+		{name: "backoff is doubled after each failure", retryCount: 3, expected: 8 * time.Minute},
+		{name: "backoff is capped", retryCount: 10, expected: time.Hour},
+		{name: "large retry counts do not overflow", retryCount: 1000, expected: time.Hour},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			backoff := dailyrefetch.GetRetryBackoff(tc.retryCount, time.Minute, time.Hour)
+			require.Equal(t, tc.expected, backoff)
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := dailyrefetch.ParseConfig([]byte(`
+daily_refetch:
+  interval: 1h
+  concurrency: 8
+`))
+	require.NoError(t, err)
+
+	expected := dailyrefetch.DefaultConfig()
+	expected.Interval = time.Hour
+	expected.Concurrency = 8
+	require.Equal(t, expected, cfg)
+
+	cfg, err = dailyrefetch.ParseConfig([]byte(`chain: {}`))
+	require.NoError(t, err)
+	require.Equal(t, dailyrefetch.DefaultConfig(), cfg)
+}
@@ -1,11 +1,17 @@
 package distribution
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	juno "github.com/forbole/juno/v5/types"
 
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
@@ -14,13 +20,90 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
+
 	if len(tx.Logs) == 0 {
 		return nil
 	}
 
-	if _, ok := msg.(*distrtypes.MsgFundCommunityPool); ok {
+	// Rewards are withdrawn both explicitly and when changing a delegation, so all messages need to be checked
+	err = m.handleDelegationRewardWithdrawals(index, tx)
+	if err != nil {
+		return err
+	}
+
+	switch msg.(type) {
+	case *distrtypes.MsgFundCommunityPool:
 		return m.updateCommunityPool(tx.Height)
+
+	case *distrtypes.MsgUpdateParams:
+		return m.UpdateParams(tx.Height)
 	}
+
 	return nil
 }
+
+// handleDelegationRewardWithdrawals stores the delegation rewards withdrawn by the message having the given index
+func (m *Module) handleDelegationRewardWithdrawals(index int, tx *juno.Tx) error {
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	withdrawals, err := ParseDelegationRewardWithdrawals(tx.Logs[index].Events, tx.TxHash, index, tx.Height, timestamp)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveDelegationRewardWithdrawals(withdrawals)
+}
+
+// ParseDelegationRewardWithdrawals returns the delegation rewards withdrawals contained inside the given events.
+// Events having the same type are merged together inside the message logs, so each withdrawal
+// starts with a new amount attribute. Withdrawals of zero rewards are ignored
+func ParseDelegationRewardWithdrawals(
+	events sdk.StringEvents, txHash string, index int, height int64, timestamp time.Time,
+) ([]types.DelegationRewardWithdrawal, error) {
+	var withdrawals []types.DelegationRewardWithdrawal
+	for _, event := range events {
+		if event.Type != distrtypes.EventTypeWithdrawRewards {
+			continue
+		}
+
+		var current *types.DelegationRewardWithdrawal
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case sdk.AttributeKeyAmount:
+				amount, err := sdk.ParseCoinsNormalized(attr.Value)
+				if err != nil {
+					return nil, fmt.Errorf("error while parsing withdrawn rewards: %s", err)
+				}
+
+				withdrawals = append(withdrawals,
+					types.NewDelegationRewardWithdrawal(txHash, index, "", "", amount, height, timestamp))
+				current = &withdrawals[len(withdrawals)-1]
+
+			case distrtypes.AttributeKeyValidator:
+				if current != nil {
+					current.ValidatorAddress = attr.Value
+				}
+
+			case distrtypes.AttributeKeyDelegator:
+				if current != nil {
+					current.DelegatorAddress = attr.Value
+				}
+			}
+		}
+	}
+
+	var result []types.DelegationRewardWithdrawal
+	for _, withdrawal := range withdrawals {
+		if withdrawal.Amount.IsZero() || withdrawal.ValidatorAddress == "" || withdrawal.DelegatorAddress == "" {
+			continue
+		}
+		result = append(result, withdrawal)
+	}
+
+	return result, nil
+}
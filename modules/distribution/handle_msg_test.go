@@ -0,0 +1,52 @@
+package distribution_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/distribution"
+)
+
+func TestParseDelegationRewardWithdrawals(t *testing.T) {
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Events emitted while redelegating, which withdraws the rewards from both validators
+	events := sdk.StringEvents{
+		{
+			Type: "coin_received",
+			Attributes: []sdk.Attribute{
+				{Key: "receiver", Value: "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"},
+				{Key: "amount", Value: "100udaric"},
+			},
+		},
+		{
+			Type: "withdraw_rewards",
+			Attributes: []sdk.Attribute{
+				{Key: "amount", Value: "100udaric"},
+				{Key: "validator", Value: "cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl"},
+				{Key: "delegator", Value: "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"},
+				{Key: "amount", Value: "0udaric"},
+				{Key: "validator", Value: "cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn"},
+				{Key: "delegator", Value: "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"},
+			},
+		},
+	}
+
+	withdrawals, err := distribution.ParseDelegationRewardWithdrawals(events, "TX_HASH", 1, 10, timestamp)
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, "cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl", withdrawals[0].ValidatorAddress)
+	require.Equal(t, "cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt", withdrawals[0].DelegatorAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)), withdrawals[0].Amount)
+	require.Equal(t, "TX_HASH", withdrawals[0].TxHash)
+	require.Equal(t, 1, withdrawals[0].MsgIndex)
+	require.Equal(t, timestamp, withdrawals[0].Timestamp)
+
+	// Invalid amounts return an error
+	events[1].Attributes[0].Value = "invalid"
+	_, err = distribution.ParseDelegationRewardWithdrawals(events, "TX_HASH", 1, 10, timestamp)
+	require.Error(t, err)
+}
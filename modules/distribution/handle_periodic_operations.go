@@ -20,6 +20,13 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while scheduling distribution periodic operation: %s", err)
 	}
 
+	// Update the APR every 1 hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateAPR)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling apr periodic operation: %s", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,160 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/rs/zerolog/log"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// realizedAPRWindows contains the number of days of the trailing windows used to compute the realized APRs
+var realizedAPRWindows = []int{7, 30, 90}
+
+// UpdateAPR computes the network nominal APR as well as the APR of each validator, and stores them inside the database
+func (m *Module) UpdateAPR() error {
+	log.Debug().Str("module", "distribution").Str("operation", "apr").Msg("updating apr")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	annualProvisions, err := m.db.GetLatestAnnualProvisions()
+	if err != nil {
+		return err
+	}
+
+	params, err := m.db.GetDistributionParams()
+	if err != nil {
+		return err
+	}
+
+	pool, err := m.db.GetStakingPool()
+	if err != nil {
+		return fmt.Errorf("error while getting staking pool: %s", err)
+	}
+
+	// Skip if the required data has not been stored yet
+	if annualProvisions == nil || params == nil || pool == nil {
+		log.Debug().Str("module", "distribution").Msg("apr data not found, skipping apr update")
+		return nil
+	}
+
+	apr := GetNominalAPR(*annualProvisions, params.CommunityTax, pool.BondedTokens)
+	err = m.db.SaveStakingAPR(types.NewStakingAPR(
+		*annualProvisions, params.CommunityTax, pool.BondedTokens, apr, GetAPY(apr), block.Height, block.BlockTimestamp,
+	))
+	if err != nil {
+		return err
+	}
+
+	stakingParams, err := m.db.GetStakingParams()
+	if err != nil {
+		return fmt.Errorf("error while getting staking params: %s", err)
+	}
+
+	withdrawnRewards := make([]map[string]sdk.Dec, len(realizedAPRWindows))
+	for i, days := range realizedAPRWindows {
+		since := block.BlockTimestamp.AddDate(0, 0, -days)
+		withdrawnRewards[i], err = m.db.GetDelegationRewardsWithdrawnSince(since, stakingParams.BondDenom)
+		if err != nil {
+			return err
+		}
+	}
+
+	validators, err := m.db.GetValidatorsStakingData()
+	if err != nil {
+		return err
+	}
+
+	validatorsAPR := make([]types.ValidatorAPR, len(validators))
+	for i, validator := range validators {
+		commission := sdk.ZeroDec()
+		if validator.Commission.Valid {
+			commission, err = sdk.NewDecFromStr(validator.Commission.String)
+			if err != nil {
+				return fmt.Errorf("error while parsing commission of validator %s: %s", validator.OperatorAddress, err)
+			}
+		}
+
+		// Only bonded validators that are not jailed receive rewards
+		validatorAPR := sdk.ZeroDec()
+		if validator.Status == int(stakingtypes.Bonded) && !validator.Jailed {
+			validatorAPR = GetValidatorAPR(apr, commission)
+		}
+
+		realizedAPRs := GetValidatorRealizedAPRs(validator, withdrawnRewards)
+		validatorsAPR[i] = types.NewValidatorAPR(
+			validator.ConsensusAddress,
+			commission,
+			validatorAPR,
+			GetAPY(validatorAPR),
+			realizedAPRs[0],
+			realizedAPRs[1],
+			realizedAPRs[2],
+			block.Height,
+			block.BlockTimestamp,
+		)
+	}
+
+	return m.db.SaveValidatorsAPR(validatorsAPR)
+}
+
+// GetValidatorRealizedAPRs returns the realized APRs of the given validator for each of the realized APR windows,
+// given the rewards withdrawn by the delegators of each validator during each window.
+// The voting power stored by the staking module contains the validator tokens, so it is used as is
+// without relying on the power reduction of the chain
+func GetValidatorRealizedAPRs(
+	validator dbtypes.ValidatorStakingDataRow, withdrawnRewards []map[string]sdk.Dec,
+) []*sdk.Dec {
+	tokens := sdkmath.NewInt(validator.VotingPower)
+
+	realizedAPRs := make([]*sdk.Dec, len(realizedAPRWindows))
+	for i, days := range realizedAPRWindows {
+		withdrawn, ok := withdrawnRewards[i][validator.OperatorAddress]
+		if !ok {
+			withdrawn = sdk.ZeroDec()
+		}
+		realizedAPRs[i] = GetRealizedAPR(withdrawn, tokens, days)
+	}
+
+	return realizedAPRs
+}
+
+// GetNominalAPR returns the APR that all the bonded tokens receive from the given annual provisions,
+// after removing the community tax
+func GetNominalAPR(annualProvisions sdk.Dec, communityTax sdk.Dec, bondedTokens sdkmath.Int) sdk.Dec {
+	if !bondedTokens.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return annualProvisions.Mul(sdk.OneDec().Sub(communityTax)).QuoInt(bondedTokens)
+}
+
+// GetValidatorAPR returns the APR that the delegators of a validator receive after removing the validator commission
+func GetValidatorAPR(nominalAPR sdk.Dec, commission sdk.Dec) sdk.Dec {
+	return nominalAPR.Mul(sdk.OneDec().Sub(commission))
+}
+
+// GetAPY returns the yield obtained by compounding the given APR once per day
+func GetAPY(apr sdk.Dec) sdk.Dec {
+	return sdk.OneDec().Add(apr.QuoInt64(365)).Power(365).Sub(sdk.OneDec())
+}
+
+// GetRealizedAPR returns the APR corresponding to the given amount of rewards having been withdrawn during the
+// given number of days by the delegators of the given tokens, or nil if there are no tokens.
+// Since rewards can be withdrawn at any time, the result underestimates the yield when delegators
+// do not withdraw their rewards frequently
+func GetRealizedAPR(withdrawn sdk.Dec, tokens sdkmath.Int, days int) *sdk.Dec {
+	if !tokens.IsPositive() || days <= 0 {
+		return nil
+	}
+
+	apr := withdrawn.MulInt64(365).QuoInt64(int64(days)).QuoInt(tokens)
+	return &apr
+}
@@ -0,0 +1,63 @@
+package distribution_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dbtypes "github.com/forbole/callisto/v4/database/types"
+	"github.com/forbole/callisto/v4/modules/distribution"
+)
+
+func TestGetNominalAPR(t *testing.T) {
+	apr := distribution.GetNominalAPR(sdk.NewDec(1000), sdk.NewDecWithPrec(2, 2), sdk.NewInt(5000))
+	require.Equal(t, sdk.NewDecWithPrec(196, 3), apr)
+
+	// No bonded tokens
+	apr = distribution.GetNominalAPR(sdk.NewDec(1000), sdk.NewDecWithPrec(2, 2), sdk.ZeroInt())
+	require.True(t, apr.IsZero())
+}
+
+func TestGetValidatorAPR(t *testing.T) {
+	apr := distribution.GetValidatorAPR(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(5, 2))
+	require.Equal(t, sdk.NewDecWithPrec(19, 2), apr)
+}
+
+func TestGetAPY(t *testing.T) {
+	require.True(t, distribution.GetAPY(sdk.ZeroDec()).IsZero())
+
+	// An APR of 10% compounded daily gives an APY of about 10.5156%
+	apy := distribution.GetAPY(sdk.NewDecWithPrec(1, 1))
+	require.True(t, apy.GT(sdk.NewDecWithPrec(105155, 6)), apy.String())
+	require.True(t, apy.LT(sdk.NewDecWithPrec(105157, 6)), apy.String())
+}
+
+func TestGetRealizedAPR(t *testing.T) {
+	apr := distribution.GetRealizedAPR(sdk.NewDec(73), sdk.NewInt(10000), 73)
+	require.NotNil(t, apr)
+	require.Equal(t, sdk.NewDecWithPrec(365, 4), *apr)
+
+	require.Nil(t, distribution.GetRealizedAPR(sdk.NewDec(73), sdk.ZeroInt(), 73))
+}
+
+func TestGetValidatorRealizedAPRs(t *testing.T) {
+	// Use a power reduction different from the default one, which should not change the result
+	defaultPowerReduction := sdk.DefaultPowerReduction
+	sdk.DefaultPowerReduction = sdkmath.NewIntWithDecimal(1, 18)
+	defer func() { sdk.DefaultPowerReduction = defaultPowerReduction }()
+
+	validator := dbtypes.ValidatorStakingDataRow{OperatorAddress: "cosmosvaloper1", VotingPower: 36500}
+	withdrawnRewards := []map[string]sdk.Dec{
+		{"cosmosvaloper1": sdk.NewDec(7)},
+		{"cosmosvaloper1": sdk.NewDec(30)},
+		{},
+	}
+
+	aprs := distribution.GetValidatorRealizedAPRs(validator, withdrawnRewards)
+	require.Len(t, aprs, 3)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), *aprs[0])
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), *aprs[1])
+	require.True(t, aprs[2].IsZero())
+}
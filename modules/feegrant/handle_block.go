@@ -2,6 +2,7 @@ package feegrant
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -11,27 +12,78 @@ import (
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/rs/zerolog/log"
 
+	"github.com/forbole/callisto/v4/modules/utils"
 	"github.com/forbole/callisto/v4/types"
 )
 
 // HandleBlock implements BlockModule
 func (m *Module) HandleBlock(
 	block *tmctypes.ResultBlock, res *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
-) error {
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
 
-	// Remove expired fee grant allowances
-	err := m.removeExpiredFeeGrantAllowances(block.Block.Height, res.EndBlockEvents)
+	// Mark expired fee grant allowances
+	err = m.expireFeeGrantAllowances(block.Block.Height, block.Block.Time, res.EndBlockEvents)
 	if err != nil {
 		fmt.Printf("Error when removing expired fee grant allowance, error: %s", err)
 	}
+
+	// Reset the periods of the periodic allowances that have ended
+	err = m.resetAllowancesPeriod(block.Block.Height, block.Block.Time)
+	if err != nil {
+		return fmt.Errorf("error while resetting fee grant allowances period: %s", err)
+	}
+
 	return nil
 }
 
-// removeExpiredFeeGrantAllowances removes fee grant allowances in database that have expired
-func (m *Module) removeExpiredFeeGrantAllowances(height int64, events []abci.Event) error {
+// resetAllowancesPeriod updates the amount that can be spent during the current period and the end of
+// the current period of the active periodic allowances whose period has ended before the given block time
+func (m *Module) resetAllowancesPeriod(height int64, timestamp time.Time) error {
+	rows, err := m.db.GetFeeGrantAllowancesToResetPeriod(height, timestamp)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		var allowance feegranttypes.FeeAllowanceI
+		err = m.cdc.UnmarshalInterfaceJSON([]byte(row.RemainingAllowance), &allowance)
+		if err != nil {
+			return fmt.Errorf("error while unmarshaling fee grant allowance: %s", err)
+		}
+
+		limits, err := GetAllowanceLimitsAt(allowance, timestamp)
+		if err != nil {
+			// Expired allowances are marked as such separately
+			log.Debug().Str("module", "feegrant").Uint64("id", row.ID).Err(err).
+				Msg("skipping fee grant allowance period reset")
+			continue
+		}
+
+		if limits.PeriodReset == nil {
+			continue
+		}
+
+		err = m.db.ResetFeeGrantAllowancePeriod(row.ID, limits.PeriodCanSpend, *limits.PeriodReset, height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireFeeGrantAllowances marks as expired the fee grant allowances in database
+// that have expired or that have been revoked at the end of the block
+func (m *Module) expireFeeGrantAllowances(height int64, timestamp time.Time, events []abci.Event) error {
 	log.Debug().Str("module", "feegrant").Int64("height", height).
 		Msg("updating expired fee grant allowances")
 
+	err := m.db.ExpireFeeGrantAllowances(height, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while expiring fee grant allowances: %s", err)
+	}
+
 	events = juno.FindEventsByType(events, feegranttypes.EventTypeRevokeFeeGrant)
 
 	for _, event := range events {
@@ -43,9 +95,11 @@ func (m *Module) removeExpiredFeeGrantAllowances(height int64, events []abci.Eve
 		if err != nil {
 			return fmt.Errorf("error while getting fee grant grantee address: %s", err)
 		}
-		err = m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(granteeAddress.Value, granterAddress.Value, height))
+		err = m.db.RevokeFeeGrantAllowance(
+			types.NewGrantRemoval(granteeAddress.Value, granterAddress.Value, height), types.FeeGrantStatusExpired,
+		)
 		if err != nil {
-			return fmt.Errorf("error while deleting fee grant allowance: %s", err)
+			return fmt.Errorf("error while expiring fee grant allowance: %s", err)
 
 		}
 	}
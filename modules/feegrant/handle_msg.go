@@ -2,12 +2,14 @@ package feegrant
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 	juno "github.com/forbole/juno/v5/types"
 
+	"github.com/forbole/callisto/v4/modules/utils"
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -17,7 +19,9 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
+
 	if len(tx.Logs) == 0 {
 		return nil
 	}
@@ -55,5 +59,7 @@ func (m *Module) HandleMsgGrantAllowance(tx *juno.Tx, msg *feegranttypes.MsgGran
 
 // HandleMsgRevokeAllowance allows to properly handle a MsgRevokeAllowance
 func (m *Module) HandleMsgRevokeAllowance(tx *juno.Tx, msg *feegranttypes.MsgRevokeAllowance) error {
-	return m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(msg.Grantee, msg.Granter, tx.Height))
+	return m.db.RevokeFeeGrantAllowance(
+		types.NewGrantRemoval(msg.Grantee, msg.Granter, tx.Height), types.FeeGrantStatusRevoked,
+	)
 }
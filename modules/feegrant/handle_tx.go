@@ -0,0 +1,80 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+	"github.com/forbole/callisto/v4/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerTx, time.Now(), &err)
+
+	// Fees are paid by the ante handler, so the usage must be tracked for failed transactions as well
+	events := juno.FindEventsByType(tx.Events, feegranttypes.EventTypeUseFeeGrant)
+	if len(events) == 0 {
+		return nil
+	}
+
+	granter, err := juno.FindAttributeByKey(events[0], feegranttypes.AttributeKeyGranter)
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant granter address: %s", err)
+	}
+
+	grantee, err := juno.FindAttributeByKey(events[0], feegranttypes.AttributeKeyGrantee)
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant grantee address: %s", err)
+	}
+
+	return m.handleFeeGrantUsage(tx, grantee.Value, granter.Value)
+}
+
+// handleFeeGrantUsage stores the fee paid by the given granter on behalf of the given grantee inside the given
+// transaction, and updates the remaining limits of the allowance that has been used
+func (m *Module) handleFeeGrantUsage(tx *juno.Tx, grantee string, granter string) error {
+	log.Debug().Str("module", "feegrant").Int64("height", tx.Height).Str("tx", tx.TxHash).
+		Msg("updating fee grant usage")
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	fee := tx.AuthInfo.Fee.Amount
+	usage := types.NewFeeGrantUsage(tx.TxHash, grantee, granter, fee, tx.Height, timestamp)
+
+	row, err := m.db.GetActiveFeeGrantAllowance(grantee, granter, tx.Height)
+	if err != nil {
+		return err
+	}
+
+	// The allowance might have been granted before the first parsed height
+	if row == nil {
+		return m.db.SaveFeeGrantUsage(usage, nil)
+	}
+
+	var allowance feegranttypes.FeeAllowanceI
+	err = m.cdc.UnmarshalInterfaceJSON([]byte(row.RemainingAllowance), &allowance)
+	if err != nil {
+		return fmt.Errorf("error while unmarshaling fee grant allowance: %s", err)
+	}
+
+	remove, err := UseAllowance(allowance, fee, tx.GetMsgs(), timestamp)
+	if err != nil {
+		return fmt.Errorf("error while using fee grant allowance: %s", err)
+	}
+
+	status := types.FeeGrantStatusActive
+	if remove {
+		status = types.FeeGrantStatusExhausted
+	}
+
+	update := types.NewFeeGrantAllowanceUpdate(row.ID, allowance, row.Spent.ToCoins().Add(fee...), status, tx.Height)
+	return m.db.SaveFeeGrantUsage(usage, &update)
+}
@@ -9,9 +9,10 @@ import (
 )
 
 var (
-	_ modules.BlockModule   = &Module{}
-	_ modules.Module        = &Module{}
-	_ modules.MessageModule = &Module{}
+	_ modules.BlockModule       = &Module{}
+	_ modules.Module            = &Module{}
+	_ modules.TransactionModule = &Module{}
+	_ modules.MessageModule     = &Module{}
 )
 
 // Module represent x/feegrant module
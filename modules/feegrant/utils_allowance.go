@@ -0,0 +1,35 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// UseAllowance uses the given allowance to pay the given fee for the given messages at the given block time,
+// updating its remaining limits the same way the x/feegrant keeper does.
+// It returns true if the allowance should be removed since it has been used up
+func UseAllowance(
+	allowance feegranttypes.FeeAllowanceI, fee sdk.Coins, msgs []sdk.Msg, blockTime time.Time,
+) (bool, error) {
+	ctx := sdk.Context{}.
+		WithBlockTime(blockTime).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	return allowance.Accept(ctx, fee, msgs)
+}
+
+// GetAllowanceLimitsAt returns the limits of the given allowance at the given block time.
+// The period of periodic allowances is reset the same way the x/feegrant keeper does once they are used,
+// so that the returned limits include the amount that can be spent during the current period
+func GetAllowanceLimitsAt(allowance feegranttypes.FeeAllowanceI, blockTime time.Time) (types.FeeGrantLimits, error) {
+	_, err := UseAllowance(allowance, sdk.NewCoins(), nil, blockTime)
+	if err != nil {
+		return types.FeeGrantLimits{}, err
+	}
+
+	return types.NewFeeGrantLimits(allowance)
+}
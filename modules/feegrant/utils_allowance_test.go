@@ -0,0 +1,125 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/feegrant"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestUseAllowance_Basic(t *testing.T) {
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	allowance := &feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))}
+
+	remove, err := feegrant.UseAllowance(allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 40)), nil, blockTime)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	limits, err := types.NewFeeGrantLimits(allowance)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 60)), limits.SpendLimit)
+
+	remove, err = feegrant.UseAllowance(allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 60)), nil, blockTime)
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestUseAllowance_Periodic(t *testing.T) {
+	periodReset := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	allowance := &feegranttypes.PeriodicAllowance{
+		Basic:            feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))},
+		Period:           24 * time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)),
+		PeriodReset:      periodReset,
+	}
+
+	// Use the allowance within the first period
+	_, err := feegrant.UseAllowance(
+		allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), nil, periodReset.Add(-time.Hour),
+	)
+	require.NoError(t, err)
+
+	limits, err := types.NewFeeGrantLimits(allowance)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 80)), limits.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), limits.PeriodCanSpend)
+	require.Equal(t, periodReset, *limits.PeriodReset)
+
+	// Exceed the period limit
+	_, err = feegrant.UseAllowance(
+		allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), nil, periodReset.Add(-time.Minute),
+	)
+	require.Error(t, err)
+
+	// Use the allowance after the period has been reset
+	allowance.PeriodCanSpend = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+	_, err = feegrant.UseAllowance(
+		allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), nil, periodReset.Add(time.Hour),
+	)
+	require.NoError(t, err)
+
+	limits, err = types.NewFeeGrantLimits(allowance)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 60)), limits.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), limits.PeriodCanSpend)
+	require.Equal(t, periodReset.Add(24*time.Hour), *limits.PeriodReset)
+}
+
+func TestUseAllowance_AllowedMsg(t *testing.T) {
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	allowance, err := feegranttypes.NewAllowedMsgAllowance(
+		&feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))},
+		[]string{sdk.MsgTypeURL(&govtypesv1.MsgVote{})},
+	)
+	require.NoError(t, err)
+
+	// Use the allowance with a not allowed message
+	_, err = feegrant.UseAllowance(
+		allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 40)), []sdk.Msg{&banktypes.MsgSend{}}, blockTime,
+	)
+	require.Error(t, err)
+
+	// Use the allowance with an allowed message
+	_, err = feegrant.UseAllowance(
+		allowance, sdk.NewCoins(sdk.NewInt64Coin("uatom", 40)), []sdk.Msg{&govtypesv1.MsgVote{}}, blockTime,
+	)
+	require.NoError(t, err)
+
+	limits, err := types.NewFeeGrantLimits(allowance)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 60)), limits.SpendLimit)
+	require.Equal(t, []string{sdk.MsgTypeURL(&govtypesv1.MsgVote{})}, limits.AllowedMessages)
+}
+
+func TestGetAllowanceLimitsAt(t *testing.T) {
+	periodReset := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	allowance := &feegranttypes.PeriodicAllowance{
+		Basic:            feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))},
+		Period:           24 * time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)),
+		PeriodReset:      periodReset,
+	}
+
+	// The period has not ended yet
+	limits, err := feegrant.GetAllowanceLimitsAt(allowance, periodReset.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)), limits.PeriodCanSpend)
+	require.Equal(t, periodReset, *limits.PeriodReset)
+
+	// More than one period has passed without using the allowance
+	blockTime := periodReset.Add(36 * time.Hour)
+	limits, err = feegrant.GetAllowanceLimitsAt(allowance, blockTime)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), limits.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)), limits.PeriodCanSpend)
+	require.Equal(t, blockTime.Add(24*time.Hour), *limits.PeriodReset)
+}
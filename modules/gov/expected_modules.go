@@ -4,6 +4,10 @@ import (
 	"github.com/forbole/callisto/v4/types"
 )
 
+type BankModule interface {
+	UpdateParams(height int64) error
+}
+
 type DistrModule interface {
 	UpdateParams(height int64) error
 }
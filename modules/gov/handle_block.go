@@ -3,6 +3,7 @@ package gov
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	juno "github.com/forbole/juno/v5/types"
 
@@ -12,14 +13,18 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleBlock implements modules.BlockModule
 func (m *Module) HandleBlock(
 	b *tmctypes.ResultBlock, blockResults *tmctypes.ResultBlockResults, txs []*juno.Tx, _ *tmctypes.ResultValidators,
-) error {
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
 	txEvents := collectTxEvents(txs)
-	err := m.updateProposalsStatus(b.Block.Height, txEvents, blockResults.EndBlockEvents)
+	err = m.updateProposalsStatus(b.Block.Height, txEvents, blockResults.EndBlockEvents)
 	if err != nil {
 		log.Error().Str("module", "gov").Int64("height", b.Block.Height).
 			Err(err).Msg("error while updating proposals")
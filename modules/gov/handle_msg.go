@@ -17,6 +17,8 @@ import (
 
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
@@ -25,7 +27,9 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
+
 	if len(tx.Logs) == 0 {
 		return nil
 	}
@@ -42,6 +46,9 @@ func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
 
 	case *govtypesv1.MsgVoteWeighted:
 		return m.handleMsgVoteWeighted(tx, cosmosMsg)
+
+	case *govtypesv1.MsgUpdateParams:
+		return m.UpdateParams(tx.Height)
 	}
 
 	return nil
@@ -22,6 +22,7 @@ type Module struct {
 	cdc            codec.Codec
 	db             *database.Db
 	source         govsource.Source
+	bankModule     BankModule
 	distrModule    DistrModule
 	mintModule     MintModule
 	slashingModule SlashingModule
@@ -31,6 +32,7 @@ type Module struct {
 // NewModule returns a new Module instance
 func NewModule(
 	source govsource.Source,
+	bankModule BankModule,
 	distrModule DistrModule,
 	mintModule MintModule,
 	slashingModule SlashingModule,
@@ -41,6 +43,7 @@ func NewModule(
 	return &Module{
 		cdc:            cdc,
 		source:         source,
+		bankModule:     bankModule,
 		distrModule:    distrModule,
 		mintModule:     mintModule,
 		slashingModule: slashingModule,
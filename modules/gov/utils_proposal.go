@@ -11,6 +11,7 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/rs/zerolog/log"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -32,13 +33,13 @@ func (m *Module) UpdateProposalStatus(height int64, id uint64) error {
 		// Check if proposal exist on the chain
 		if strings.Contains(err.Error(), codes.NotFound.String()) && strings.Contains(err.Error(), "doesn't exist") {
 			// Handle case when a proposal is deleted from the chain (did not pass deposit period)
-			return m.updateDeletedProposalStatus(id)
+			return m.updateDeletedProposalStatus(height, id)
 		}
 
 		return fmt.Errorf("error while getting proposal: %s", err)
 	}
 
-	err = m.updateProposalStatus(proposal)
+	err = m.updateProposalStatus(height, proposal)
 	if err != nil {
 		return fmt.Errorf("error while updating proposal status: %s", err)
 	}
@@ -51,8 +52,8 @@ func (m *Module) UpdateProposalStatus(height int64, id uint64) error {
 	return nil
 }
 
-// updateProposalStatus updates given proposal status
-func (m *Module) updateProposalStatus(proposal *govtypesv1.Proposal) error {
+// updateProposalStatus updates given proposal status at the given height
+func (m *Module) updateProposalStatus(height int64, proposal *govtypesv1.Proposal) error {
 	return m.db.UpdateProposal(
 		types.NewProposalUpdate(
 			proposal.Id,
@@ -60,6 +61,7 @@ func (m *Module) updateProposalStatus(proposal *govtypesv1.Proposal) error {
 			proposal.VotingStartTime,
 			proposal.VotingEndTime,
 		),
+		height,
 	)
 }
 
@@ -102,7 +104,7 @@ func (m *Module) UpdateProposalStakingPoolSnapshot(height int64, proposalID uint
 
 // updateDeletedProposalStatus updates the proposal having the given id by setting its status
 // to the one that represents a deleted proposal
-func (m *Module) updateDeletedProposalStatus(id uint64) error {
+func (m *Module) updateDeletedProposalStatus(height int64, id uint64) error {
 	stored, err := m.db.GetProposal(id)
 	if err != nil {
 		return err
@@ -115,12 +117,18 @@ func (m *Module) updateDeletedProposalStatus(id uint64) error {
 			stored.VotingStartTime,
 			stored.VotingEndTime,
 		),
+		height,
 	)
 }
 
 // handleParamChangeProposal updates params to the corresponding modules if a ParamChangeProposal has passed
 func (m *Module) handleParamChangeProposal(height int64, moduleName string) (err error) {
 	switch moduleName {
+	case banktypes.ModuleName:
+		err = m.bankModule.UpdateParams(height)
+		if err != nil {
+			return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", banktypes.ModuleName, err)
+		}
 	case distrtypes.ModuleName:
 		err = m.distrModule.UpdateParams(height)
 		if err != nil {
@@ -187,16 +195,22 @@ func (m *Module) UpdateProposalTallyResult(proposalID uint64, height int64) erro
 		return fmt.Errorf("error while getting tally result: %s", err)
 	}
 
-	return m.db.SaveTallyResults([]types.TallyResult{
-		types.NewTallyResult(
-			proposalID,
-			result.YesCount,
-			result.AbstainCount,
-			result.NoCount,
-			result.NoWithVetoCount,
-			height,
-		),
-	})
+	tally := types.NewTallyResult(
+		proposalID,
+		result.YesCount,
+		result.AbstainCount,
+		result.NoCount,
+		result.NoWithVetoCount,
+		height,
+	)
+
+	err = m.db.SaveTallyResults([]types.TallyResult{tally})
+	if err != nil {
+		return err
+	}
+
+	// Refresh the projected outcome using the updated tally
+	return m.updateProposalTallyProjection(tally)
 }
 
 func (m *Module) handlePassedProposal(proposal *govtypesv1.Proposal, height int64) error {
@@ -235,16 +249,16 @@ func (m *Module) handlePassedV1Proposal(proposal *govtypesv1.Proposal, msg sdk.M
 	switch msg := msg.(type) {
 	case *upgradetypes.MsgSoftwareUpgrade:
 		// Store software upgrade plan while SoftwareUpgradeProposal passed
-		err := m.db.SaveSoftwareUpgradePlan(proposal.Id, msg.Plan, height)
+		err := m.handleScheduledUpgrade(proposal.Id, msg.Plan, height)
 		if err != nil {
-			return fmt.Errorf("error while storing software upgrade plan: %s", err)
+			return err
 		}
 
 	case *upgradetypes.MsgCancelUpgrade:
 		// Delete software upgrade plan while CancelSoftwareUpgradeProposal passed
-		err := m.db.DeleteSoftwareUpgradePlan(proposal.Id)
+		err := m.handleCancelledUpgrade(proposal.Id, height)
 		if err != nil {
-			return fmt.Errorf("error while deleting software upgrade plan: %s", err)
+			return err
 		}
 
 	default:
@@ -266,6 +280,8 @@ func (m *Module) handlePassedV1Proposal(proposal *govtypesv1.Proposal, msg sdk.M
 // If the message is not a param change proposal, it returns false
 func getParamChangeSubspace(msg sdk.Msg) (string, bool) {
 	switch msg.(type) {
+	case *banktypes.MsgUpdateParams:
+		return banktypes.ModuleName, true
 	case *distrtypes.MsgUpdateParams:
 		return distrtypes.ModuleName, true
 	case *govtypesv1.MsgUpdateParams:
@@ -303,16 +319,48 @@ func (m *Module) handlePassedV1Beta1Proposal(proposal *govtypesv1.Proposal, msg
 		}
 	case *upgradetypes.SoftwareUpgradeProposal:
 		// Store software upgrade plan while SoftwareUpgradeProposal passed
-		err = m.db.SaveSoftwareUpgradePlan(proposal.Id, p.Plan, height)
+		err = m.handleScheduledUpgrade(proposal.Id, p.Plan, height)
 		if err != nil {
-			return fmt.Errorf("error while storing software upgrade plan: %s", err)
+			return err
 		}
 	case *upgradetypes.CancelSoftwareUpgradeProposal:
 		// Delete software upgrade plan while CancelSoftwareUpgradeProposal passed
-		err = m.db.DeleteSoftwareUpgradePlan(proposal.Id)
+		err = m.handleCancelledUpgrade(proposal.Id, height)
 		if err != nil {
-			return fmt.Errorf("error while deleting software upgrade plan: %s", err)
+			return err
 		}
 	}
 	return nil
 }
+
+// handleScheduledUpgrade stores the given software upgrade plan, scheduled by the proposal having the given id
+func (m *Module) handleScheduledUpgrade(proposalID uint64, plan upgradetypes.Plan, height int64) error {
+	err := m.db.SaveSoftwareUpgradePlan(proposalID, plan, height)
+	if err != nil {
+		return fmt.Errorf("error while storing software upgrade plan: %s", err)
+	}
+
+	err = m.db.SaveScheduledUpgrade(proposalID, plan, height)
+	if err != nil {
+		return fmt.Errorf("error while storing upgrade history: %s", err)
+	}
+
+	return nil
+}
+
+// handleCancelledUpgrade removes the currently scheduled software upgrade plan, cancelled by the proposal
+// having the given id, keeping track of the cancellation inside the upgrade history
+func (m *Module) handleCancelledUpgrade(proposalID uint64, height int64) error {
+	// Only one plan can be scheduled at a time, so the cancellation applies to all the stored ones
+	err := m.db.DeleteScheduledSoftwareUpgradePlans(height)
+	if err != nil {
+		return fmt.Errorf("error while deleting software upgrade plan: %s", err)
+	}
+
+	err = m.db.SaveCancelledUpgrade(proposalID, height)
+	if err != nil {
+		return fmt.Errorf("error while storing upgrade history: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package gov
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// updateProposalTallyProjection computes the outcome that the proposal associated with the given tally result
+// would have if its voting period ended now, and stores it inside the database
+func (m *Module) updateProposalTallyProjection(tally types.TallyResult) error {
+	params, err := m.db.GetGovParams()
+	if err != nil {
+		return fmt.Errorf("error while getting gov params: %s", err)
+	}
+
+	// Skip if the params have not been stored yet
+	if params == nil {
+		log.Debug().Str("module", "gov").Uint64("proposal", tally.ProposalID).
+			Msg("gov params not found, skipping tally projection")
+		return nil
+	}
+
+	pool, err := m.db.GetProposalStakingPoolSnapshot(tally.ProposalID)
+	if err != nil {
+		return err
+	}
+
+	// Use the current staking pool if no snapshot has been stored yet
+	if pool == nil {
+		pool, err = m.stakingModule.GetStakingPoolSnapshot(tally.Height)
+		if err != nil {
+			return fmt.Errorf("error while getting staking pool snapshot: %s", err)
+		}
+	}
+
+	projection, err := ProjectTallyResult(tally, pool.BondedTokens, params.Params)
+	if err != nil {
+		return fmt.Errorf("error while projecting tally result: %s", err)
+	}
+
+	return m.db.SaveProposalTallyProjection(projection)
+}
+
+// ProjectTallyResult returns the outcome that a proposal having the given tally result would have if the voting
+// period ended with the given amount of bonded tokens and gov params.
+// The checks are performed in the same order used by the x/gov module when tallying a proposal.
+func ProjectTallyResult(
+	tally types.TallyResult, bondedTokens sdkmath.Int, params *govtypesv1.Params,
+) (types.ProposalTallyProjection, error) {
+	quorum, err := sdk.NewDecFromStr(params.Quorum)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing quorum: %s", err)
+	}
+
+	threshold, err := sdk.NewDecFromStr(params.Threshold)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing threshold: %s", err)
+	}
+
+	vetoThreshold, err := sdk.NewDecFromStr(params.VetoThreshold)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing veto threshold: %s", err)
+	}
+
+	yes, err := sdk.NewDecFromStr(tally.Yes)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing yes count: %s", err)
+	}
+
+	abstain, err := sdk.NewDecFromStr(tally.Abstain)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing abstain count: %s", err)
+	}
+
+	no, err := sdk.NewDecFromStr(tally.No)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing no count: %s", err)
+	}
+
+	noWithVeto, err := sdk.NewDecFromStr(tally.NoWithVeto)
+	if err != nil {
+		return types.ProposalTallyProjection{}, fmt.Errorf("error while parsing no with veto count: %s", err)
+	}
+
+	totalVoted := yes.Add(abstain).Add(no).Add(noWithVeto)
+	nonAbstaining := totalVoted.Sub(abstain)
+
+	turnout := sdk.ZeroDec()
+	if bondedTokens.IsPositive() {
+		turnout = totalVoted.QuoInt(bondedTokens)
+	}
+
+	vetoRatio := sdk.ZeroDec()
+	if totalVoted.IsPositive() {
+		vetoRatio = noWithVeto.Quo(totalVoted)
+	}
+
+	yesRatio := sdk.ZeroDec()
+	if nonAbstaining.IsPositive() {
+		yesRatio = yes.Quo(nonAbstaining)
+	}
+
+	quorumReached := bondedTokens.IsPositive() && !turnout.LT(quorum)
+
+	status := govtypesv1.StatusRejected
+	switch {
+	case !quorumReached:
+		// If there are no staked tokens or not enough voting power has voted, the proposal fails
+	case nonAbstaining.IsZero():
+		// If everyone abstains, the proposal fails
+	case vetoRatio.GT(vetoThreshold):
+		// If more than the veto threshold of voters veto, the proposal fails
+	case yesRatio.GT(threshold):
+		status = govtypesv1.StatusPassed
+	}
+
+	return types.NewProposalTallyProjection(
+		tally.ProposalID,
+		turnout,
+		quorum,
+		quorumReached,
+		yesRatio,
+		threshold,
+		vetoRatio,
+		vetoThreshold,
+		status.String(),
+		tally.Height,
+	), nil
+}
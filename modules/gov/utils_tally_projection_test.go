@@ -0,0 +1,118 @@
+package gov_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/gov"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func TestProjectTallyResult(t *testing.T) {
+	params := &govtypesv1.Params{
+		Quorum:        "0.334",
+		Threshold:     "0.5",
+		VetoThreshold: "0.334",
+	}
+
+	testCases := []struct {
+		name         string
+		tally        types.TallyResult
+		bonded       int64
+		expQuorum    bool
+		expStatus    govtypesv1.ProposalStatus
+		expTurnout   sdk.Dec
+		expYesRatio  sdk.Dec
+		expVetoRatio sdk.Dec
+		shouldErr    bool
+	}{
+		{
+			name:         "no bonded tokens is rejected",
+			tally:        types.NewTallyResult(1, "10", "0", "0", "0", 10),
+			bonded:       0,
+			expQuorum:    false,
+			expStatus:    govtypesv1.StatusRejected,
+			expTurnout:   sdk.ZeroDec(),
+			expYesRatio:  sdk.OneDec(),
+			expVetoRatio: sdk.ZeroDec(),
+		},
+		{
+			name:         "quorum not reached is rejected",
+			tally:        types.NewTallyResult(1, "30", "0", "0", "0", 10),
+			bonded:       100,
+			expQuorum:    false,
+			expStatus:    govtypesv1.StatusRejected,
+			expTurnout:   sdk.NewDecWithPrec(3, 1),
+			expYesRatio:  sdk.OneDec(),
+			expVetoRatio: sdk.ZeroDec(),
+		},
+		{
+			name:         "all abstain is rejected",
+			tally:        types.NewTallyResult(1, "0", "50", "0", "0", 10),
+			bonded:       100,
+			expQuorum:    true,
+			expStatus:    govtypesv1.StatusRejected,
+			expTurnout:   sdk.NewDecWithPrec(5, 1),
+			expYesRatio:  sdk.ZeroDec(),
+			expVetoRatio: sdk.ZeroDec(),
+		},
+		{
+			name:         "veto threshold exceeded is rejected",
+			tally:        types.NewTallyResult(1, "30", "0", "0", "20", 10),
+			bonded:       100,
+			expQuorum:    true,
+			expStatus:    govtypesv1.StatusRejected,
+			expTurnout:   sdk.NewDecWithPrec(5, 1),
+			expYesRatio:  sdk.NewDecWithPrec(6, 1),
+			expVetoRatio: sdk.NewDecWithPrec(4, 1),
+		},
+		{
+			name:         "yes equal to threshold is rejected",
+			tally:        types.NewTallyResult(1, "25", "10", "25", "0", 10),
+			bonded:       100,
+			expQuorum:    true,
+			expStatus:    govtypesv1.StatusRejected,
+			expTurnout:   sdk.NewDecWithPrec(6, 1),
+			expYesRatio:  sdk.NewDecWithPrec(5, 1),
+			expVetoRatio: sdk.ZeroDec(),
+		},
+		{
+			name:         "yes above threshold is passed",
+			tally:        types.NewTallyResult(1, "30", "20", "10", "0", 10),
+			bonded:       100,
+			expQuorum:    true,
+			expStatus:    govtypesv1.StatusPassed,
+			expTurnout:   sdk.NewDecWithPrec(6, 1),
+			expYesRatio:  sdk.NewDecWithPrec(75, 2),
+			expVetoRatio: sdk.ZeroDec(),
+		},
+		{
+			name:      "invalid tally returns error",
+			tally:     types.NewTallyResult(1, "invalid", "0", "0", "0", 10),
+			bonded:    100,
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			projection, err := gov.ProjectTallyResult(tc.tally, sdk.NewInt(tc.bonded), params)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expQuorum, projection.QuorumReached)
+			require.Equal(t, tc.expStatus.String(), projection.ProjectedStatus)
+			require.True(t, tc.expTurnout.Equal(projection.Turnout))
+			require.True(t, tc.expYesRatio.Equal(projection.YesRatio))
+			require.True(t, tc.expVetoRatio.Equal(projection.VetoRatio))
+			require.Equal(t, tc.tally.Height, projection.Height)
+		})
+	}
+}
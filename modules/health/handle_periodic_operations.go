@@ -0,0 +1,55 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// heightsInterval represents how often the indexed and node heights are checked
+const heightsInterval = 15 * time.Second
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "health").Msg("setting up periodic tasks")
+
+	// Setup a cron job to track how far the database is behind the node
+	if _, err := scheduler.Every(heightsInterval).StartImmediately().Do(func() {
+		utils.WatchMethodSingleton(m.updateHeights)
+	}); err != nil {
+		return fmt.Errorf("error while setting up health periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// updateHeights updates the latest indexed height, the latest node height and the lag between them
+func (m *Module) updateHeights() error {
+	indexedHeight, err := m.db.GetLastBlockHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting latest indexed height: %s", err)
+	}
+
+	nodeHeight, err := m.node.LatestHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting latest node height: %s", err)
+	}
+
+	IndexedHeightGauge.Set(float64(indexedHeight))
+	NodeHeightGauge.Set(float64(nodeHeight))
+	LagGauge.Set(float64(GetLag(indexedHeight, nodeHeight)))
+
+	return nil
+}
+
+// GetLag returns the number of blocks the given indexed height is behind the given node height
+func GetLag(indexedHeight, nodeHeight int64) int64 {
+	if nodeHeight <= indexedHeight {
+		return 0
+	}
+	return nodeHeight - indexedHeight
+}
@@ -0,0 +1,32 @@
+package health
+
+import (
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/node"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module allowing to track the health of the indexer
+type Module struct {
+	node node.Node
+	db   *database.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(node node.Node, db *database.Db) *Module {
+	return &Module{
+		node: node,
+		db:   db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "health"
+}
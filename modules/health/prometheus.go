@@ -0,0 +1,43 @@
+package health
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// IndexedHeightGauge represents the Telemetry gauge used to track the latest block height stored inside the database
+var IndexedHeightGauge = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "callisto_indexed_height",
+		Help: "Latest block height stored inside the database.",
+	},
+)
+
+// NodeHeightGauge represents the Telemetry gauge used to track the latest block height of the node
+var NodeHeightGauge = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "callisto_node_height",
+		Help: "Latest block height of the node.",
+	},
+)
+
+// LagGauge represents the Telemetry gauge used to track the number of blocks
+// the database is behind the node
+var LagGauge = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "callisto_indexer_lag_blocks",
+		Help: "Number of blocks the latest stored block is behind the latest block of the node.",
+	},
+)
+
+func init() {
+	for _, collector := range []prometheus.Collector{
+		IndexedHeightGauge,
+		NodeHeightGauge,
+		LagGauge,
+	} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
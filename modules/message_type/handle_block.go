@@ -0,0 +1,80 @@
+package message_type
+
+import (
+	"fmt"
+	"time"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	utils "github.com/forbole/callisto/v4/modules/utils"
+	msgtypes "github.com/forbole/callisto/v4/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, txs []*types.Tx, _ *tmctypes.ResultValidators,
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
+	// Skip the blocks without transactions
+	if len(txs) == 0 {
+		return nil
+	}
+
+	log.Debug().Str("module", "message_type").Int64("height", b.Block.Height).
+		Msg("updating message types")
+
+	stats := GetMessageTypeBlockStats(txs)
+
+	// Save the message types before the messages referencing them are stored
+	for _, stat := range stats {
+		err := m.db.SaveMessageType(msgtypes.NewMessageType(
+			stat.Type,
+			utils.GetModuleNameFromTypeURL(stat.Type),
+			utils.GetMsgFromTypeURL(stat.Type),
+			b.Block.Height))
+		if err != nil {
+			return fmt.Errorf("error while saving message type %s: %s", stat.Type, err)
+		}
+	}
+
+	return m.db.SaveMessageTypeBlockStats(b.Block.Height, b.Block.Time, stats)
+}
+
+// GetMessageTypeBlockStats returns the usage of each message type inside the given transactions.
+// The sender of each message is its first signer
+func GetMessageTypeBlockStats(txs []*types.Tx) []msgtypes.MessageTypeBlockStats {
+	var stats []msgtypes.MessageTypeBlockStats
+	indexes := map[string]int{}
+	seenSenders := map[string]bool{}
+
+	for _, tx := range txs {
+		for _, msg := range tx.GetMsgs() {
+			msgType := proto.MessageName(msg)
+
+			index, found := indexes[msgType]
+			if !found {
+				index = len(stats)
+				indexes[msgType] = index
+				stats = append(stats, msgtypes.NewMessageTypeBlockStats(msgType, 0, nil))
+			}
+			stats[index].MessagesCount++
+
+			signers := msg.GetSigners()
+			if len(signers) == 0 {
+				continue
+			}
+
+			sender := signers[0].String()
+			if !seenSenders[msgType+sender] {
+				seenSenders[msgType+sender] = true
+				stats[index].Senders = append(stats[index].Senders, sender)
+			}
+		}
+	}
+
+	return stats
+}
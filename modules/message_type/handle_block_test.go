@@ -0,0 +1,57 @@
+package message_type_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	messagetype "github.com/forbole/callisto/v4/modules/message_type"
+	"github.com/forbole/callisto/v4/types"
+)
+
+func buildTx(t *testing.T, msgs ...sdk.Msg) *juno.Tx {
+	var anys []*codectypes.Any
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys = append(anys, msgAny)
+	}
+
+	return &juno.Tx{
+		Tx:         &txtypes.Tx{Body: &txtypes.TxBody{Messages: anys}},
+		TxResponse: &sdk.TxResponse{},
+	}
+}
+
+func TestGetMessageTypeBlockStats(t *testing.T) {
+	first := "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+	second := "cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a"
+
+	send := func(sender string) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: sender,
+			ToAddress:   "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+		}
+	}
+
+	txs := []*juno.Tx{
+		buildTx(t, send(first), send(first)),
+		buildTx(t, send(second), &distrtypes.MsgFundCommunityPool{
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+			Depositor: first,
+		}),
+	}
+
+	stats := messagetype.GetMessageTypeBlockStats(txs)
+	require.Equal(t, []types.MessageTypeBlockStats{
+		types.NewMessageTypeBlockStats("cosmos.bank.v1beta1.MsgSend", 3, []string{first, second}),
+		types.NewMessageTypeBlockStats("cosmos.distribution.v1beta1.MsgFundCommunityPool", 1, []string{first}),
+	}, stats)
+}
@@ -1,27 +1,26 @@
 package message_type
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/forbole/juno/v5/types"
+
 	utils "github.com/forbole/callisto/v4/modules/utils"
 	msgtypes "github.com/forbole/callisto/v4/types"
-
-	"github.com/forbole/juno/v5/types"
 )
 
-// HandleMsg represents a message handler that stores the given message inside the proper database table
-func (m *Module) HandleMsg(
-	index int, msg sdk.Msg, tx *types.Tx) error {
-	// Save message type
-	err := m.db.SaveMessageType(msgtypes.NewMessageType(
-		proto.MessageName(msg),
-		utils.GetModuleNameFromTypeURL(proto.MessageName(msg)),
-		utils.GetMsgFromTypeURL(proto.MessageName(msg)),
-		tx.Height))
-
-	if err != nil {
-		return err
-	}
+// HandleMsg implements modules.MessageModule.
+// Message types are also stored while handling the messages, so that the transactions parsed
+// without their blocks (eg. using the parse transactions command) still update them
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *types.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
 
-	return nil
+	msgType := proto.MessageName(msg)
+	return m.db.SaveMessageType(msgtypes.NewMessageType(
+		msgType,
+		utils.GetModuleNameFromTypeURL(msgType),
+		utils.GetMsgFromTypeURL(msgType),
+		tx.Height))
 }
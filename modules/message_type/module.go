@@ -9,6 +9,7 @@ import (
 
 var (
 	_ modules.Module        = &Module{}
+	_ modules.BlockModule   = &Module{}
 	_ modules.MessageModule = &Module{}
 )
 
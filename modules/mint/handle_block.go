@@ -0,0 +1,51 @@
+package mint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/forbole/juno/v5/types"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ []*types.Tx, _ *tmctypes.ResultValidators,
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
+	err = m.updateInflationHistory(b.Block.Height, b.Block.Time)
+	if err != nil {
+		return fmt.Errorf("error while updating inflation history: %s", err)
+	}
+
+	return nil
+}
+
+// updateInflationHistory fetches the inflation and annual provisions at the given height,
+// and stores them inside the database along with the given block timestamp
+func (m *Module) updateInflationHistory(height int64, timestamp time.Time) error {
+	log.Debug().Str("module", "mint").Int64("height", height).
+		Msg("updating inflation history")
+
+	inflation, err := m.source.GetInflation(height)
+	if err != nil {
+		return fmt.Errorf("error while getting inflation: %s", err)
+	}
+
+	annualProvisions, err := m.source.AnnualProvisions(height)
+	if err != nil {
+		return fmt.Errorf("error while getting annual provisions: %s", err)
+	}
+
+	err = m.db.SaveInflation(inflation, height)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveInflationHistory(inflation, annualProvisions, height, timestamp)
+}
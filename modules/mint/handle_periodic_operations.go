@@ -1,6 +1,8 @@
 package mint
 
 import (
+	"fmt"
+
 	"github.com/forbole/callisto/v4/modules/utils"
 
 	"github.com/go-co-op/gocron"
@@ -13,9 +15,9 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 
 	// Setup a cron job to run every midnight
 	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
-		utils.WatchMethod(m.UpdateInflation)
+		utils.WatchMethod(m.UpdateSupplyProjection)
 	}); err != nil {
-		return err
+		return fmt.Errorf("error while setting up supply projection periodic operation: %s", err)
 	}
 
 	return nil
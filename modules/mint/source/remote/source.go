@@ -36,11 +36,21 @@ func (s Source) GetInflation(height int64) (sdk.Dec, error) {
 	return res.Inflation, nil
 }
 
+// AnnualProvisions implements mintsource.Source
+func (s Source) AnnualProvisions(height int64) (sdk.Dec, error) {
+	res, err := s.querier.AnnualProvisions(remote.GetHeightRequestContext(s.Ctx, height), &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return res.AnnualProvisions, nil
+}
+
 // Params implements mintsource.Source
 func (s Source) Params(height int64) (minttypes.Params, error) {
 	res, err := s.querier.Params(remote.GetHeightRequestContext(s.Ctx, height), &minttypes.QueryParamsRequest{})
 	if err != nil {
-		return minttypes.Params{}, nil
+		return minttypes.Params{}, err
 	}
 
 	return res.Params, nil
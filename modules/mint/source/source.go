@@ -7,5 +7,6 @@ import (
 
 type Source interface {
 	GetInflation(height int64) (sdk.Dec, error)
+	AnnualProvisions(height int64) (sdk.Dec, error)
 	Params(height int64) (minttypes.Params, error)
 }
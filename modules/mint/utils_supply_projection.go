@@ -0,0 +1,123 @@
+package mint
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+const (
+	// supplyProjectionDays represents the number of days for which the supply is projected
+	supplyProjectionDays = 5 * 365
+)
+
+// UpdateSupplyProjection computes the projected supply of the mint denom starting from the latest block,
+// and stores it inside the database
+func (m *Module) UpdateSupplyProjection() error {
+	log.Debug().Str("module", "mint").Str("operation", "supply projection").
+		Msg("updating supply projection")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	params, err := m.source.Params(block.Height)
+	if err != nil {
+		return fmt.Errorf("error while getting params: %s", err)
+	}
+
+	inflation, err := m.source.GetInflation(block.Height)
+	if err != nil {
+		return fmt.Errorf("error while getting inflation: %s", err)
+	}
+
+	supply, err := m.db.GetSupply()
+	if err != nil {
+		return err
+	}
+
+	pool, err := m.db.GetStakingPool()
+	if err != nil {
+		return err
+	}
+
+	// Skip if the supply or the staking pool have not been stored yet
+	totalSupply := supply.AmountOf(params.MintDenom)
+	if !totalSupply.IsPositive() || pool == nil {
+		log.Debug().Str("module", "mint").Msg("supply or staking pool not found, skipping supply projection")
+		return nil
+	}
+
+	bondedRatio := sdk.NewDecFromInt(pool.BondedTokens).QuoInt(totalSupply)
+	projection, err := ProjectSupply(
+		params, inflation, totalSupply, bondedRatio, block.BlockTimestamp, block.Height, supplyProjectionDays,
+	)
+	if err != nil {
+		return fmt.Errorf("error while projecting supply: %s", err)
+	}
+
+	return m.db.SaveSupplyProjection(projection)
+}
+
+// ProjectSupply returns the projected supply for each of the given number of days, starting from the given
+// inflation, supply and bonded ratio. The inflation is updated using the same formula used by the x/mint module,
+// assuming that the bonded ratio does not change over time.
+func ProjectSupply(
+	params minttypes.Params, inflation sdk.Dec, supply sdkmath.Int, bondedRatio sdk.Dec,
+	timestamp time.Time, height int64, days int,
+) ([]types.SupplyProjection, error) {
+	if !params.GoalBonded.IsPositive() {
+		return nil, fmt.Errorf("invalid goal bonded: %s", params.GoalBonded)
+	}
+
+	if params.BlocksPerYear == 0 {
+		return nil, fmt.Errorf("invalid blocks per year: %d", params.BlocksPerYear)
+	}
+
+	blocksPerDay := params.BlocksPerYear / 365
+	if blocksPerDay == 0 {
+		blocksPerDay = 1
+	}
+
+	// Fraction of a year that is covered by a single day worth of blocks
+	dayFraction := sdk.NewDec(int64(blocksPerDay)).QuoInt64(int64(params.BlocksPerYear))
+
+	// The inflation rate change per year only depends on the bonded ratio, which is assumed to be constant
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+
+	currentSupply := sdk.NewDecFromInt(supply)
+	projection := make([]types.SupplyProjection, days)
+	for day := 1; day <= days; day++ {
+		inflation = inflation.Add(inflationRateChangePerYear.Mul(dayFraction))
+		if inflation.GT(params.InflationMax) {
+			inflation = params.InflationMax
+		}
+		if inflation.LT(params.InflationMin) {
+			inflation = params.InflationMin
+		}
+
+		annualProvisions := inflation.Mul(currentSupply)
+		currentSupply = currentSupply.Add(annualProvisions.Mul(dayFraction))
+
+		projection[day-1] = types.NewSupplyProjection(
+			day,
+			timestamp.Add(time.Duration(day)*24*time.Hour),
+			height+int64(day)*int64(blocksPerDay),
+			inflation,
+			annualProvisions,
+			currentSupply,
+			height,
+		)
+	}
+
+	return projection, nil
+}
@@ -0,0 +1,104 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/mint"
+)
+
+func TestProjectSupply(t *testing.T) {
+	params := minttypes.Params{
+		MintDenom:           "stake",
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+		InflationMax:        sdk.NewDecWithPrec(20, 2),
+		InflationMin:        sdk.NewDecWithPrec(7, 2),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:       365 * 10,
+	}
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	testCases := []struct {
+		name          string
+		inflation     sdk.Dec
+		bondedRatio   sdk.Dec
+		expInflation  sdk.Dec
+		shouldErr     bool
+		overrideParam func(params minttypes.Params) minttypes.Params
+	}{
+		{
+			name:         "bonded ratio below goal increases inflation up to the max",
+			inflation:    sdk.NewDecWithPrec(10, 2),
+			bondedRatio:  sdk.ZeroDec(),
+			expInflation: params.InflationMax,
+		},
+		{
+			name:         "bonded ratio above goal decreases inflation down to the min",
+			inflation:    sdk.NewDecWithPrec(10, 2),
+			bondedRatio:  sdk.OneDec(),
+			expInflation: params.InflationMin,
+		},
+		{
+			name:         "bonded ratio equal to goal keeps inflation constant",
+			inflation:    sdk.NewDecWithPrec(10, 2),
+			bondedRatio:  params.GoalBonded,
+			expInflation: sdk.NewDecWithPrec(10, 2),
+		},
+		{
+			name:        "zero goal bonded returns error",
+			inflation:   sdk.NewDecWithPrec(10, 2),
+			bondedRatio: sdk.ZeroDec(),
+			shouldErr:   true,
+			overrideParam: func(params minttypes.Params) minttypes.Params {
+				params.GoalBonded = sdk.ZeroDec()
+				return params
+			},
+		},
+		{
+			name:        "zero blocks per year returns error",
+			inflation:   sdk.NewDecWithPrec(10, 2),
+			bondedRatio: sdk.ZeroDec(),
+			shouldErr:   true,
+			overrideParam: func(params minttypes.Params) minttypes.Params {
+				params.BlocksPerYear = 0
+				return params
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testParams := params
+			if tc.overrideParam != nil {
+				testParams = tc.overrideParam(testParams)
+			}
+
+			projection, err := mint.ProjectSupply(
+				testParams, tc.inflation, sdk.NewInt(1_000_000), tc.bondedRatio, timestamp, 100, 3*365,
+			)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, projection, 3*365)
+
+			last := projection[len(projection)-1]
+			require.True(t, tc.expInflation.Equal(last.Inflation))
+			require.Equal(t, timestamp.Add(3*365*24*time.Hour), last.Timestamp)
+			require.Equal(t, int64(100+3*365*10), last.ProjectedHeight)
+			require.Equal(t, int64(100), last.Height)
+
+			// The supply should always grow since the inflation is positive
+			for i := 1; i < len(projection); i++ {
+				require.True(t, projection[i].Supply.GT(projection[i-1].Supply))
+			}
+		})
+	}
+}
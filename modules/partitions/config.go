@@ -0,0 +1,63 @@
+package partitions
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the partitions module
+type Config struct {
+	// Interval represents how often the partitions are created and archived
+	Interval time.Duration `yaml:"interval"`
+
+	// Ahead represents the number of partitions that should exist after the one containing the latest block
+	Ahead int64 `yaml:"ahead"`
+
+	// Keep represents the number of latest partitions that should be kept attached, including the one containing
+	// the latest block. Older partitions are detached and archived. If 0, no partition is ever archived
+	Keep int64 `yaml:"keep"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(interval time.Duration, ahead int64, keep int64) *Config {
+	return &Config{
+		Interval: interval,
+		Ahead:    ahead,
+		Keep:     keep,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(time.Hour, 2, 0)
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"partitions"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	// Use the default values for the missing fields
+	defaultCfg := DefaultConfig()
+	if cfg.Config.Interval <= 0 {
+		cfg.Config.Interval = defaultCfg.Interval
+	}
+	if cfg.Config.Ahead < 0 {
+		cfg.Config.Ahead = defaultCfg.Ahead
+	}
+	if cfg.Config.Keep < 0 {
+		cfg.Config.Keep = defaultCfg.Keep
+	}
+
+	return cfg.Config, nil
+}
@@ -0,0 +1,44 @@
+package partitions
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "partitions").Msg("setting up periodic tasks")
+
+	if m.partitionSize <= 0 {
+		log.Warn().Str("module", "partitions").Msg("partition size is not set, partitions will not be managed")
+		return nil
+	}
+
+	// Setup a cron job to create the future partitions and archive the old ones
+	if _, err := scheduler.Every(m.cfg.Interval).StartImmediately().Do(func() {
+		utils.WatchMethodSingleton(m.managePartitions)
+	}); err != nil {
+		return fmt.Errorf("error while setting up partitions periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// managePartitions creates the future partitions and archives the old ones
+func (m *Module) managePartitions() error {
+	err := m.EnsurePartitions()
+	if err != nil {
+		return fmt.Errorf("error while creating partitions: %s", err)
+	}
+
+	err = m.ArchivePartitions(m.cfg.Keep)
+	if err != nil {
+		return fmt.Errorf("error while archiving partitions: %s", err)
+	}
+
+	return nil
+}
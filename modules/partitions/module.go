@@ -0,0 +1,44 @@
+package partitions
+
+import (
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represents the module allowing to manage the partitions of the transaction and message tables
+type Module struct {
+	cfg           *Config
+	partitionSize int64
+	db            *database.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db *database.Db) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	partitionsCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cfg:           partitionsCfg,
+		partitionSize: cfg.Database.PartitionSize,
+		db:            db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "partitions"
+}
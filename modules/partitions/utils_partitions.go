@@ -0,0 +1,100 @@
+package partitions
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// EnsurePartitions creates, for each partitioned table, the partition containing the latest stored block
+// as well as the configured number of partitions after it, if they do not exist yet
+func (m *Module) EnsurePartitions() error {
+	if m.partitionSize <= 0 {
+		return fmt.Errorf("partition size is not set")
+	}
+
+	height, err := m.db.GetLastBlockHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting last block height: %s", err)
+	}
+
+	for _, partitionID := range GetFuturePartitionIDs(height, m.partitionSize, m.cfg.Ahead) {
+		for _, table := range database.PartitionedTables {
+			err = m.db.CreatePartitionIfNotExists(table, partitionID)
+			if err != nil {
+				return fmt.Errorf("error while creating partition %s: %s",
+					database.GetPartitionName(table, partitionID), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ArchivePartitions detaches and archives, for each partitioned table, all the partitions older than
+// the given number of latest partitions. If keep is 0, nothing is archived
+func (m *Module) ArchivePartitions(keep int64) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	if m.partitionSize <= 0 {
+		return fmt.Errorf("partition size is not set")
+	}
+
+	height, err := m.db.GetLastBlockHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting last block height: %s", err)
+	}
+
+	for _, table := range database.PartitionedTables {
+		partitions, err := m.db.GetPartitions(table)
+		if err != nil {
+			return err
+		}
+
+		var partitionIDs []int64
+		for _, partition := range partitions {
+			if partitionID, ok := database.GetPartitionID(table, partition.Name); ok {
+				partitionIDs = append(partitionIDs, partitionID)
+			}
+		}
+
+		for _, partitionID := range GetPartitionsToArchive(partitionIDs, height/m.partitionSize, keep) {
+			log.Info().Str("module", "partitions").Str("partition", database.GetPartitionName(table, partitionID)).
+				Msg("archiving partition")
+
+			err = m.db.ArchivePartition(table, partitionID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetFuturePartitionIDs returns the id of the partition containing the given height,
+// followed by the ids of the given number of partitions after it
+func GetFuturePartitionIDs(height int64, partitionSize int64, ahead int64) []int64 {
+	first := height / partitionSize
+	partitionIDs := make([]int64, 0, ahead+1)
+	for partitionID := first; partitionID <= first+ahead; partitionID++ {
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	return partitionIDs
+}
+
+// GetPartitionsToArchive returns, among the given partition ids, the ones that are older than the given number
+// of latest partitions, where the latest partition is the one having the given id
+func GetPartitionsToArchive(partitionIDs []int64, latestID int64, keep int64) []int64 {
+	var toArchive []int64
+	for _, partitionID := range partitionIDs {
+		if partitionID <= latestID-keep {
+			toArchive = append(toArchive, partitionID)
+		}
+	}
+	return toArchive
+}
@@ -0,0 +1,36 @@
+package partitions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/partitions"
+)
+
+func TestGetFuturePartitionIDs(t *testing.T) {
+	require.Equal(t, []int64{0}, partitions.GetFuturePartitionIDs(0, 1000, 0))
+	require.Equal(t, []int64{12, 13, 14}, partitions.GetFuturePartitionIDs(12_999, 1000, 2))
+	require.Equal(t, []int64{13, 14, 15}, partitions.GetFuturePartitionIDs(13_000, 1000, 2))
+}
+
+func TestGetPartitionsToArchive(t *testing.T) {
+	testCases := []struct {
+		name         string
+		partitionIDs []int64
+		latestID     int64
+		keep         int64
+		expected     []int64
+	}{
+		{name: "older partitions are archived", partitionIDs: []int64{1, 2, 3, 4, 5, 6}, latestID: 5, keep: 2, expected: []int64{1, 2, 3}},
+		{name: "future partitions are never archived", partitionIDs: []int64{5, 6, 7}, latestID: 5, keep: 1, expected: nil},
+		{name: "nothing is archived while the partitions are less than the ones to keep", partitionIDs: []int64{0, 1}, latestID: 1, keep: 5, expected: nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, partitions.GetPartitionsToArchive(tc.partitionIDs, tc.latestID, tc.keep))
+		})
+	}
+}
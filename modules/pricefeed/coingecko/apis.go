@@ -7,7 +7,9 @@ import (
 	"math"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/forbole/callisto/v4/modules/utils"
 	"github.com/forbole/callisto/v4/types"
 )
 
@@ -42,8 +44,12 @@ func ConvertCoingeckoPrices(prices []MarketTicker) []types.TokenPrice {
 	return tokenPrices
 }
 
-// queryCoinGecko queries the CoinGecko APIs for the given endpoint
-func queryCoinGecko(endpoint string, ptr interface{}) error {
+// queryCoinGecko queries the CoinGecko APIs for the given endpoint,
+// tracking the request latency using the endpoint path without the query
+func queryCoinGecko(endpoint string, ptr interface{}) (err error) {
+	path, _, _ := strings.Cut(endpoint, "?")
+	defer func(start time.Time) { utils.ObserveExternalAPI("coingecko", path, start, err) }(time.Now())
+
 	resp, err := http.Get("https://api.coingecko.com/api/v3" + endpoint)
 	if err != nil {
 		return err
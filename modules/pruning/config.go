@@ -0,0 +1,140 @@
+package pruning
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// Config contains the configuration about the pruning module
+type Config struct {
+	// Frequency represents how often the data exceeding the retention policies is pruned
+	Frequency time.Duration `yaml:"frequency"`
+
+	// BatchSize represents the number of heights whose data is deleted at once from each table,
+	// so that the pruned tables are never locked for a long time
+	BatchSize int64 `yaml:"batch_size"`
+
+	// Retention contains the retention policy of each group of tables.
+	// The groups that do not have any retention policy are kept forever
+	Retention map[string]RetentionPolicy `yaml:"retention"`
+
+	// KeepRecent is the legacy number of recent heights to be kept. If set, it is used as the retention policy
+	// of the pre-commits and messages groups when they do not have one
+	KeepRecent int64 `yaml:"keep_recent"`
+
+	// KeepEvery and Interval are the legacy pruning options that are no longer supported
+	KeepEvery int64 `yaml:"keep_every"`
+	Interval  int64 `yaml:"interval"`
+}
+
+// RetentionPolicy tells for how long the data of a group of tables should be kept.
+// If both KeepBlocks and KeepDays are set, the data is kept as long as any of them requires it
+type RetentionPolicy struct {
+	// KeepBlocks represents the number of latest blocks whose data should be kept
+	KeepBlocks int64 `yaml:"keep_blocks"`
+
+	// KeepDays represents the number of days, before the latest block, whose data should be kept
+	KeepDays int64 `yaml:"keep_days"`
+
+	// KeepForever tells whether the data should never be pruned
+	KeepForever bool `yaml:"keep_forever"`
+}
+
+// NewRetentionPolicy returns a new RetentionPolicy instance
+func NewRetentionPolicy(keepBlocks, keepDays int64, keepForever bool) RetentionPolicy {
+	return RetentionPolicy{
+		KeepBlocks:  keepBlocks,
+		KeepDays:    keepDays,
+		KeepForever: keepForever,
+	}
+}
+
+// IsKeepForever tells whether the data subject to this policy should never be pruned
+func (p RetentionPolicy) IsKeepForever() bool {
+	return p.KeepForever || (p.KeepBlocks == 0 && p.KeepDays == 0)
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(frequency time.Duration, batchSize int64, retention map[string]RetentionPolicy) *Config {
+	return &Config{
+		Frequency: frequency,
+		BatchSize: batchSize,
+		Retention: retention,
+	}
+}
+
+// DefaultConfig returns the default configuration, which keeps all the data forever
+func DefaultConfig() *Config {
+	return NewConfig(time.Hour, 1000, map[string]RetentionPolicy{})
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"pruning"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Config == nil {
+		return DefaultConfig(), nil
+	}
+
+	// Use the default values for the missing fields
+	defaultCfg := DefaultConfig()
+	if cfg.Config.Frequency <= 0 {
+		cfg.Config.Frequency = defaultCfg.Frequency
+	}
+	if cfg.Config.BatchSize <= 0 {
+		cfg.Config.BatchSize = defaultCfg.BatchSize
+	}
+	if cfg.Config.Retention == nil {
+		cfg.Config.Retention = defaultCfg.Retention
+	}
+
+	// Use the legacy keep recent option for the groups that were pruned before retention policies were introduced
+	if cfg.Config.KeepRecent > 0 {
+		for _, group := range []string{database.PruningGroupPreCommits, database.PruningGroupMessages} {
+			if _, found := cfg.Config.Retention[group]; !found {
+				cfg.Config.Retention[group] = NewRetentionPolicy(cfg.Config.KeepRecent, 0, false)
+			}
+		}
+	}
+
+	return cfg.Config, nil
+}
+
+// Validate checks that the given configuration is valid
+func (cfg *Config) Validate() error {
+	for group, policy := range cfg.Retention {
+		if !isPruningGroup(group) {
+			return fmt.Errorf("invalid pruning retention group: %s, must be one of %v", group, database.PruningGroups)
+		}
+
+		if policy.KeepBlocks < 0 || policy.KeepDays < 0 {
+			return fmt.Errorf("invalid %s retention: keep_blocks and keep_days cannot be negative", group)
+		}
+
+		if policy.KeepForever && (policy.KeepBlocks > 0 || policy.KeepDays > 0) {
+			return fmt.Errorf("invalid %s retention: keep_forever cannot be used along with keep_blocks or keep_days", group)
+		}
+	}
+
+	return nil
+}
+
+// isPruningGroup tells whether the given group is a valid pruning group
+func isPruningGroup(group string) bool {
+	for _, pruningGroup := range database.PruningGroups {
+		if group == pruningGroup {
+			return true
+		}
+	}
+	return false
+}
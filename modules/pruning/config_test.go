@@ -0,0 +1,82 @@
+package pruning_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/pruning"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := pruning.ParseConfig([]byte(`
+pruning:
+  frequency: 10m
+  retention:
+    transactions:
+      keep_days: 30
+    history:
+      keep_forever: true
+    pre_commits:
+      keep_blocks: 1000
+      keep_days: 1
+`))
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, 10*time.Minute, cfg.Frequency)
+	require.Equal(t, pruning.DefaultConfig().BatchSize, cfg.BatchSize)
+	require.Equal(t, map[string]pruning.RetentionPolicy{
+		"transactions": pruning.NewRetentionPolicy(0, 30, false),
+		"history":      pruning.NewRetentionPolicy(0, 0, true),
+		"pre_commits":  pruning.NewRetentionPolicy(1000, 1, false),
+	}, cfg.Retention)
+
+	// Missing config should keep everything
+	cfg, err = pruning.ParseConfig([]byte(`invalid_field: yes`))
+	require.NoError(t, err)
+	require.Equal(t, pruning.DefaultConfig(), cfg)
+}
+
+func TestParseConfig_LegacyKeepRecent(t *testing.T) {
+	cfg, err := pruning.ParseConfig([]byte(`
+pruning:
+  keep_recent: 100
+  keep_every: 10
+  interval: 1
+  retention:
+    messages:
+      keep_forever: true
+`))
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, map[string]pruning.RetentionPolicy{
+		"pre_commits": pruning.NewRetentionPolicy(100, 0, false),
+		"messages":    pruning.NewRetentionPolicy(0, 0, true),
+	}, cfg.Retention)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		retention map[string]pruning.RetentionPolicy
+		shouldErr bool
+	}{
+		{name: "unknown group returns error", retention: map[string]pruning.RetentionPolicy{"blocks": pruning.NewRetentionPolicy(10, 0, false)}, shouldErr: true},
+		{name: "negative limit returns error", retention: map[string]pruning.RetentionPolicy{"messages": pruning.NewRetentionPolicy(-1, 0, false)}, shouldErr: true},
+		{name: "keep forever with limits returns error", retention: map[string]pruning.RetentionPolicy{"messages": pruning.NewRetentionPolicy(10, 0, true)}, shouldErr: true},
+		{name: "valid retention returns no error", retention: map[string]pruning.RetentionPolicy{"messages": pruning.NewRetentionPolicy(10, 2, false)}, shouldErr: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := pruning.NewConfig(time.Hour, 100, tc.retention).Validate()
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
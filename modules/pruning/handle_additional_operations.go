@@ -0,0 +1,15 @@
+package pruning
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+// RunAdditionalOperations implements modules.AdditionalOperationsModule
+func (m *Module) RunAdditionalOperations() error {
+	if m.cfg.KeepEvery > 0 || m.cfg.Interval > 0 {
+		log.Warn().Str("module", "pruning").
+			Msg("keep_every and interval pruning options are no longer supported, use retention and frequency instead")
+	}
+
+	return m.cfg.Validate()
+}
@@ -0,0 +1,29 @@
+package pruning
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "pruning").Msg("setting up periodic tasks")
+
+	// Setup a cron job to prune the data exceeding the retention policies
+	if _, err := scheduler.Every(m.cfg.Frequency).Do(func() {
+		utils.WatchMethodSingleton(m.pruneExpiredData)
+	}); err != nil {
+		return fmt.Errorf("error while setting up pruning periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// pruneExpiredData prunes the data exceeding the retention policies
+func (m *Module) pruneExpiredData() error {
+	return m.PruneExpiredData()
+}
@@ -0,0 +1,43 @@
+package pruning
+
+import (
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+var (
+	_ modules.Module                     = &Module{}
+	_ modules.PeriodicOperationsModule   = &Module{}
+	_ modules.AdditionalOperationsModule = &Module{}
+)
+
+// Module represents the pruning module allowing to delete the data exceeding the configured retention policies
+type Module struct {
+	cfg *Config
+	db  *database.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db *database.Db) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	pruningCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cfg: pruningCfg,
+		db:  db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "pruning"
+}
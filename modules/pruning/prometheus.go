@@ -0,0 +1,36 @@
+package pruning
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrunedRowsCounter represents the Telemetry counter used to track the total number of pruned rows of each table
+var PrunedRowsCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "callisto_pruned_rows_total",
+		Help: "Total number of rows deleted by the pruning, per table.",
+	},
+	[]string{"table"},
+)
+
+// PrunedHeightGauge represents the Telemetry gauge used to track the height below which
+// the data of each group of tables has been pruned
+var PrunedHeightGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "callisto_pruned_height",
+		Help: "Height below which the data of each group of tables has been pruned.",
+	},
+	[]string{"group"},
+)
+
+func init() {
+	for _, collector := range []prometheus.Collector{
+		PrunedRowsCounter,
+		PrunedHeightGauge,
+	} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package pruning
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/database"
+)
+
+// PruneExpiredData deletes the data of each group of tables that exceeds its retention policy,
+// starting from the height below which the group has already been pruned
+func (m *Module) PruneExpiredData() error {
+	log.Trace().Str("module", "pruning").Msg("pruning expired data")
+
+	lastBlock, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	// Skip if there are no blocks yet
+	if lastBlock.Height == 0 {
+		return nil
+	}
+
+	for _, group := range database.PruningGroups {
+		policy, found := m.cfg.Retention[group]
+		if !found || policy.IsKeepForever() {
+			continue
+		}
+
+		var daysHeight int64
+		if policy.KeepDays > 0 {
+			since := lastBlock.BlockTimestamp.Add(-time.Duration(policy.KeepDays) * 24 * time.Hour)
+			daysHeight, err = m.db.GetFirstBlockHeightSince(since)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = m.pruneGroup(group, GetRetentionHeight(policy, lastBlock.Height, daysHeight))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRetentionHeight returns the lowest height whose data should be kept according to the given policy,
+// given the latest stored height and the height of the first block stored within the policy days.
+// The data of all the heights below the returned one can be pruned. If the returned height is 0, nothing can be pruned
+func GetRetentionHeight(policy RetentionPolicy, lastHeight int64, daysHeight int64) int64 {
+	if policy.IsKeepForever() {
+		return 0
+	}
+
+	var retentionHeight int64 = -1
+	if policy.KeepBlocks > 0 {
+		retentionHeight = lastHeight - policy.KeepBlocks + 1
+	}
+
+	// Keep the data as long as any of the limits requires it
+	if policy.KeepDays > 0 && (retentionHeight == -1 || daysHeight < retentionHeight) {
+		retentionHeight = daysHeight
+	}
+
+	if retentionHeight < 0 {
+		return 0
+	}
+	return retentionHeight
+}
+
+// pruneGroup deletes, in batches, the data of the given group of tables stored below the given height
+func (m *Module) pruneGroup(group string, retentionHeight int64) error {
+	from, err := m.db.GetPrunedHeight(group)
+	if err != nil {
+		return err
+	}
+
+	// Start from the first stored block if the group has never been pruned
+	if from == 0 {
+		firstBlock, err := m.db.GetFirstBlockHeightAndTimestamp()
+		if err != nil {
+			return err
+		}
+		from = firstBlock.Height
+	}
+
+	for from < retentionHeight {
+		to := from + m.cfg.BatchSize
+		if to > retentionHeight {
+			to = retentionHeight
+		}
+
+		pruned, err := m.db.PruneGroup(group, from, to)
+		if err != nil {
+			return err
+		}
+
+		err = m.db.SavePrunedHeight(group, to)
+		if err != nil {
+			return err
+		}
+
+		for table, rows := range pruned {
+			PrunedRowsCounter.WithLabelValues(table).Add(float64(rows))
+		}
+		PrunedHeightGauge.WithLabelValues(group).Set(float64(to))
+
+		log.Debug().Str("module", "pruning").Str("group", group).Int64("from height", from).
+			Int64("to height", to).Msg("pruned heights")
+
+		from = to
+	}
+
+	return nil
+}
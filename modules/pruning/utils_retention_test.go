@@ -0,0 +1,34 @@
+package pruning_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/pruning"
+)
+
+func TestGetRetentionHeight(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policy     pruning.RetentionPolicy
+		daysHeight int64
+		expected   int64
+	}{
+		{name: "keep forever prunes nothing", policy: pruning.NewRetentionPolicy(0, 0, true), expected: 0},
+		{name: "empty policy prunes nothing", policy: pruning.NewRetentionPolicy(0, 0, false), expected: 0},
+		{name: "keep blocks keeps the latest blocks", policy: pruning.NewRetentionPolicy(100, 0, false), expected: 901},
+		{name: "keep blocks greater than the chain prunes nothing", policy: pruning.NewRetentionPolicy(5000, 0, false), expected: 0},
+		{name: "keep days keeps the blocks within the days", policy: pruning.NewRetentionPolicy(0, 7, false), daysHeight: 500, expected: 500},
+		{name: "keep days without blocks within the days prunes nothing", policy: pruning.NewRetentionPolicy(0, 7, false), daysHeight: 0, expected: 0},
+		{name: "both limits keep the most data", policy: pruning.NewRetentionPolicy(100, 7, false), daysHeight: 500, expected: 500},
+		{name: "both limits keep the most blocks", policy: pruning.NewRetentionPolicy(600, 7, false), daysHeight: 950, expected: 401},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, pruning.GetRetentionHeight(tc.policy, 1000, tc.daysHeight))
+		})
+	}
+}
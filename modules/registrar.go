@@ -2,9 +2,9 @@ package modules
 
 import (
 	"github.com/forbole/callisto/v4/modules/actions"
+	"github.com/forbole/callisto/v4/modules/analytics"
 	"github.com/forbole/callisto/v4/modules/types"
 
-	"github.com/forbole/juno/v5/modules/pruning"
 	"github.com/forbole/juno/v5/modules/telemetry"
 
 	"github.com/forbole/callisto/v4/modules/slashing"
@@ -18,16 +18,20 @@ import (
 	"github.com/forbole/callisto/v4/database"
 	"github.com/forbole/callisto/v4/modules/auth"
 	"github.com/forbole/callisto/v4/modules/bank"
+	blockbatch "github.com/forbole/callisto/v4/modules/block_batch"
 	"github.com/forbole/callisto/v4/modules/consensus"
 	"github.com/forbole/callisto/v4/modules/distribution"
 	"github.com/forbole/callisto/v4/modules/feegrant"
 
 	dailyrefetch "github.com/forbole/callisto/v4/modules/daily_refetch"
 	"github.com/forbole/callisto/v4/modules/gov"
+	"github.com/forbole/callisto/v4/modules/health"
 	messagetype "github.com/forbole/callisto/v4/modules/message_type"
 	"github.com/forbole/callisto/v4/modules/mint"
 	"github.com/forbole/callisto/v4/modules/modules"
+	"github.com/forbole/callisto/v4/modules/partitions"
 	"github.com/forbole/callisto/v4/modules/pricefeed"
+	"github.com/forbole/callisto/v4/modules/pruning"
 	"github.com/forbole/callisto/v4/modules/staking"
 	"github.com/forbole/callisto/v4/modules/upgrade"
 	juno "github.com/forbole/juno/v5/types"
@@ -73,39 +77,51 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 		panic(err)
 	}
 
-	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig)
+	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig, db)
+	analyticsModule := analytics.NewModule(r.parser, db)
 	authModule := auth.NewModule(r.parser, cdc, db)
-	bankModule := bank.NewModule(r.parser, sources.BankSource, cdc, db)
-	consensusModule := consensus.NewModule(db)
-	dailyRefetchModule := dailyrefetch.NewModule(ctx.Proxy, db)
+	bankModule := bank.NewModule(ctx.JunoConfig, r.parser, sources.BankSource, cdc, db)
+	dailyRefetchModule := dailyrefetch.NewModule(ctx.JunoConfig, ctx.EncodingConfig, ctx.Proxy, db, ctx.Logger)
 	distrModule := distribution.NewModule(sources.DistrSource, cdc, db)
 	feegrantModule := feegrant.NewModule(cdc, db)
 	messagetypeModule := messagetype.NewModule(r.parser, cdc, db)
 	mintModule := mint.NewModule(sources.MintSource, cdc, db)
 	slashingModule := slashing.NewModule(sources.SlashingSource, cdc, db)
 	stakingModule := staking.NewModule(sources.StakingSource, cdc, db)
-	govModule := gov.NewModule(sources.GovSource, distrModule, mintModule, slashingModule, stakingModule, cdc, db)
-	upgradeModule := upgrade.NewModule(db, stakingModule)
+	consensusModule := consensus.NewModule(ctx.Proxy, stakingModule, db)
+	govModule := gov.NewModule(sources.GovSource, bankModule, distrModule, mintModule, slashingModule, stakingModule, cdc, db)
+	upgradeModule := upgrade.NewModule(db, stakingModule, []upgrade.ParamsModule{
+		bankModule, distrModule, govModule, mintModule, slashingModule, stakingModule,
+	})
 
-	return []jmodules.Module{
+	mods := []jmodules.Module{
 		messages.NewModule(r.parser, cdc, ctx.Database),
 		telemetry.NewModule(ctx.JunoConfig),
-		pruning.NewModule(ctx.JunoConfig, db, ctx.Logger),
 
 		actionsModule,
+		analyticsModule,
 		authModule,
 		bankModule,
+		blockbatch.NewModule(ctx.JunoConfig, db, ctx.Logger),
 		consensusModule,
 		dailyRefetchModule,
 		distrModule,
 		feegrantModule,
 		govModule,
+		health.NewModule(ctx.Proxy, db),
 		mintModule,
 		messagetypeModule,
 		modules.NewModule(ctx.JunoConfig.Chain, db),
+		partitions.NewModule(ctx.JunoConfig, db),
 		pricefeed.NewModule(ctx.JunoConfig, cdc, db),
+		pruning.NewModule(ctx.JunoConfig, db),
 		slashingModule,
 		stakingModule,
 		upgradeModule,
 	}
+
+	// Refetched blocks must be handled by the same modules as the parsed ones
+	dailyRefetchModule.SetModules(mods)
+
+	return mods
 }
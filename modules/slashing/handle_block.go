@@ -2,19 +2,24 @@ package slashing
 
 import (
 	"fmt"
+	"time"
 
 	juno "github.com/forbole/juno/v5/types"
 
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleBlock implements BlockModule
 func (m *Module) HandleBlock(
 	block *tmctypes.ResultBlock, results *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
-) error {
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
 	// Update the signing infos
-	err := m.updateSigningInfo(block.Block.Height)
+	err = m.UpdateSigningInfo(block.Block.Height)
 	if err != nil {
 		return fmt.Errorf("error while updating signing info: %s", err)
 	}
@@ -22,8 +27,8 @@ func (m *Module) HandleBlock(
 	return nil
 }
 
-// updateSigningInfo reads from the LCD the current staking pool and stores its value inside the database
-func (m *Module) updateSigningInfo(height int64) error {
+// UpdateSigningInfo gets the validators signing infos at the given height and stores them inside the database
+func (m *Module) UpdateSigningInfo(height int64) error {
 	log.Debug().Str("module", "slashing").Int64("height", height).Msg("updating signing info")
 
 	signingInfos, err := m.getSigningInfos(height)
@@ -12,6 +12,7 @@ var (
 	_ modules.Module        = &Module{}
 	_ modules.GenesisModule = &Module{}
 	_ modules.BlockModule   = &Module{}
+	_ modules.MessageModule = &Module{}
 )
 
 // Module represent x/slashing module
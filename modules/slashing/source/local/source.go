@@ -70,7 +70,7 @@ func (s Source) GetParams(height int64) (slashingtypes.Params, error) {
 
 	res, err := s.querier.Params(sdk.WrapSDKContext(ctx), &slashingtypes.QueryParamsRequest{})
 	if err != nil {
-		return slashingtypes.Params{}, nil
+		return slashingtypes.Params{}, fmt.Errorf("error while getting slashing params: %s", err)
 	}
 
 	return res.Params, nil
@@ -61,7 +61,7 @@ func (s Source) GetSigningInfos(height int64) ([]slashingtypes.ValidatorSigningI
 func (s Source) GetParams(height int64) (slashingtypes.Params, error) {
 	res, err := s.querier.Params(remote.GetHeightRequestContext(s.Ctx, height), &slashingtypes.QueryParamsRequest{})
 	if err != nil {
-		return slashingtypes.Params{}, nil
+		return slashingtypes.Params{}, err
 	}
 
 	return res.Params, nil
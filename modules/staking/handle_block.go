@@ -3,6 +3,7 @@ package staking
 import (
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/forbole/callisto/v4/types"
 
@@ -11,14 +12,18 @@ import (
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleBlock implements BlockModule
 func (m *Module) HandleBlock(
 	block *tmctypes.ResultBlock, res *tmctypes.ResultBlockResults, _ []*juno.Tx, vals *tmctypes.ResultValidators,
-) error {
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
 	// Update the validators
-	_, err := m.updateValidators(block.Block.Height)
+	_, err = m.updateValidators(block.Block.Height)
 	if err != nil {
 		return fmt.Errorf("error while updating validators: %s", err)
 	}
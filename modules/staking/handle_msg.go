@@ -2,12 +2,15 @@ package staking
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
@@ -16,7 +19,9 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 }
 
 // HandleMsg implements MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerMsg, time.Now(), &err)
+
 	if len(tx.Logs) == 0 {
 		return nil
 	}
@@ -40,6 +45,9 @@ func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
 	case *stakingtypes.MsgUndelegate:
 		return m.UpdateValidatorStatuses()
 
+	case *stakingtypes.MsgUpdateParams:
+		return m.UpdateParams(tx.Height)
+
 	}
 
 	return nil
@@ -27,6 +27,13 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up gov period operations: %s", err)
 	}
 
+	// Update the decentralization metrics every 1 hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateDecentralizationMetrics)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling decentralization metrics periodic operation: %s", err)
+	}
+
 	return nil
 }
 
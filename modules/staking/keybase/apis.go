@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // GetAvatarURL returns the avatar URL from the given identity.
@@ -42,8 +46,12 @@ func GetAvatarURL(identity string) (string, error) {
 }
 
 // queryKeyBase queries the Keybase APIs for the given endpoint, and de-serializes
-// the response as a JSON object inside the given ptr
-func queryKeyBase(endpoint string, ptr interface{}) error {
+// the response as a JSON object inside the given ptr.
+// The request latency is tracked using the endpoint path without the query
+func queryKeyBase(endpoint string, ptr interface{}) (err error) {
+	path, _, _ := strings.Cut(endpoint, "?")
+	defer func(start time.Time) { utils.ObserveExternalAPI("keybase", path, start, err) }(time.Now())
+
 	resp, err := http.Get("https://keybase.io/_/api/1.0" + endpoint)
 	if err != nil {
 		return fmt.Errorf("error while querying keybase APIs: %s", err)
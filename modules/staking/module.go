@@ -1,7 +1,10 @@
 package staking
 
 import (
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/forbole/juno/v5/modules"
 
 	"github.com/forbole/callisto/v4/database"
@@ -21,6 +24,11 @@ type Module struct {
 	cdc    codec.Codec
 	db     *database.Db
 	source stakingsource.Source
+
+	// validators contains the validators fetched at the latest heights, so that the ones fetched
+	// while handling a block can be reused by the other modules handling the same block
+	validators      map[int64][]stakingtypes.Validator
+	validatorsMutex sync.Mutex
 }
 
 // NewModule returns a new Module instance
@@ -31,6 +39,8 @@ func NewModule(
 		cdc:    cdc,
 		db:     db,
 		source: source,
+
+		validators: make(map[int64][]stakingtypes.Validator),
 	}
 }
 
@@ -0,0 +1,126 @@
+package staking
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/callisto/v4/types"
+)
+
+// UpdateDecentralizationMetrics computes the decentralization metrics from the voting power of the active validators,
+// and stores them inside the database
+func (m *Module) UpdateDecentralizationMetrics() error {
+	log.Debug().Str("module", "staking").Str("operation", "decentralization metrics").
+		Msg("updating decentralization metrics")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	validators, err := m.db.GetValidatorsStakingData()
+	if err != nil {
+		return err
+	}
+
+	var votingPowers []int64
+	for _, validator := range validators {
+		if validator.Status == int(stakingtypes.Bonded) && !validator.Jailed && validator.VotingPower > 0 {
+			votingPowers = append(votingPowers, validator.VotingPower)
+		}
+	}
+
+	// Skip if there are no active validators yet
+	if len(votingPowers) == 0 {
+		log.Debug().Str("module", "staking").Msg("no active validators found, skipping decentralization metrics")
+		return nil
+	}
+
+	metrics := GetDecentralizationMetrics(votingPowers, block.Height, block.BlockTimestamp)
+	return m.db.SaveDecentralizationMetrics(metrics)
+}
+
+// GetDecentralizationMetrics returns the decentralization metrics of a validator set having the given voting powers.
+// The Nakamoto coefficients are the minimum number of validators that together hold more than 1/3 and 2/3
+// of the total voting power, which are the amounts needed to halt the chain and to commit blocks respectively
+func GetDecentralizationMetrics(votingPowers []int64, height int64, timestamp time.Time) types.DecentralizationMetrics {
+	sorted := make([]int64, len(votingPowers))
+	copy(sorted, votingPowers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	var total int64
+	for _, power := range sorted {
+		total += power
+	}
+
+	if total == 0 {
+		return types.NewDecentralizationMetrics(
+			len(sorted), 0, 0, 0,
+			sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+			height, timestamp,
+		)
+	}
+
+	totalDec := sdk.NewDec(total)
+	n := int64(len(sorted))
+
+	var nakamoto33, nakamoto66 int
+	var cumulative int64
+	herfindahl := sdk.ZeroDec()
+	weightedSum := sdk.ZeroDec()
+	for i, power := range sorted {
+		cumulative += power
+		if nakamoto33 == 0 && sdk.NewDec(cumulative).MulInt64(3).GT(totalDec) {
+			nakamoto33 = i + 1
+		}
+		if nakamoto66 == 0 && sdk.NewDec(cumulative).MulInt64(3).GT(totalDec.MulInt64(2)) {
+			nakamoto66 = i + 1
+		}
+
+		share := sdk.NewDec(power).Quo(totalDec)
+		herfindahl = herfindahl.Add(share.Mul(share))
+
+		// The Gini coefficient formula requires the values to be sorted in ascending order
+		weightedSum = weightedSum.Add(sdk.NewDec(power).MulInt64(n - int64(i)))
+	}
+
+	// G = 2 * sum(i * x_i) / (n * sum(x_i)) - (n + 1) / n, with i starting from 1 on ascending values
+	gini := weightedSum.MulInt64(2).Quo(totalDec.MulInt64(n)).Sub(sdk.NewDec(n + 1).QuoInt64(n))
+	if gini.IsNegative() {
+		gini = sdk.ZeroDec()
+	}
+
+	return types.NewDecentralizationMetrics(
+		len(sorted),
+		total,
+		nakamoto33,
+		nakamoto66,
+		gini,
+		herfindahl,
+		getTopShare(sorted, total, 5),
+		getTopShare(sorted, total, 10),
+		getTopShare(sorted, total, 20),
+		height,
+		timestamp,
+	)
+}
+
+// getTopShare returns the share of the total voting power held by the top validators.
+// The given voting powers must be sorted in descending order
+func getTopShare(sorted []int64, total int64, top int) sdk.Dec {
+	if top > len(sorted) {
+		top = len(sorted)
+	}
+
+	var sum int64
+	for _, power := range sorted[:top] {
+		sum += power
+	}
+
+	return sdk.NewDec(sum).QuoInt64(total)
+}
@@ -0,0 +1,49 @@
+package staking_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/staking"
+)
+
+func TestGetDecentralizationMetrics(t *testing.T) {
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("equal voting powers", func(t *testing.T) {
+		metrics := staking.GetDecentralizationMetrics([]int64{10, 10, 10, 10}, 10, timestamp)
+		require.Equal(t, 4, metrics.ValidatorsCount)
+		require.Equal(t, int64(40), metrics.TotalVotingPower)
+		require.Equal(t, 2, metrics.NakamotoCoefficient33)
+		require.Equal(t, 3, metrics.NakamotoCoefficient66)
+		require.True(t, metrics.GiniCoefficient.IsZero(), metrics.GiniCoefficient.String())
+		require.Equal(t, sdk.NewDecWithPrec(25, 2), metrics.HerfindahlIndex)
+		require.Equal(t, sdk.OneDec(), metrics.Top5Share)
+		require.Equal(t, int64(10), metrics.Height)
+		require.Equal(t, timestamp, metrics.Timestamp)
+	})
+
+	t.Run("concentrated voting powers", func(t *testing.T) {
+		metrics := staking.GetDecentralizationMetrics([]int64{10, 70, 10, 10}, 10, timestamp)
+		require.Equal(t, 1, metrics.NakamotoCoefficient33)
+		require.Equal(t, 1, metrics.NakamotoCoefficient66)
+		require.Equal(t, sdk.NewDecWithPrec(52, 2), metrics.HerfindahlIndex)
+		require.Equal(t, sdk.NewDecWithPrec(45, 2), metrics.GiniCoefficient)
+	})
+
+	t.Run("top shares", func(t *testing.T) {
+		votingPowers := make([]int64, 25)
+		for i := range votingPowers {
+			votingPowers[i] = int64(i + 1)
+		}
+
+		// The total is 325, the top 5 hold 115 and the top 10 hold 205
+		metrics := staking.GetDecentralizationMetrics(votingPowers, 10, timestamp)
+		require.Equal(t, sdk.NewDec(115).QuoInt64(325), metrics.Top5Share)
+		require.Equal(t, sdk.NewDec(205).QuoInt64(325), metrics.Top10Share)
+		require.Equal(t, 25, metrics.ValidatorsCount)
+	})
+}
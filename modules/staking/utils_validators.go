@@ -13,6 +13,10 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// validatorsCacheSize represents the number of heights for which the fetched validators are kept,
+// which should be enough to cover the blocks being handled concurrently
+const validatorsCacheSize = 16
+
 // getValidatorConsPubKey returns the consensus public key of the given validator
 func (m *Module) getValidatorConsPubKey(validator stakingtypes.Validator) (cryptotypes.PubKey, error) {
 	var pubKey cryptotypes.PubKey
@@ -138,22 +142,79 @@ func (m *Module) GetValidatorsWithStatus(height int64, status string) ([]staking
 		return nil, nil, err
 	}
 
+	vals, err := m.convertValidators(height, validators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validators, vals, nil
+}
+
+// convertValidators converts the given staking validators into Callisto validators
+func (m *Module) convertValidators(height int64, validators []stakingtypes.Validator) ([]types.Validator, error) {
 	var vals = make([]types.Validator, len(validators))
 	for index, val := range validators {
 		validator, err := m.convertValidator(height, val)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error while converting validator: %s", err)
+			return nil, fmt.Errorf("error while converting validator: %s", err)
 		}
 
 		vals[index] = validator
 	}
 
-	return validators, vals, nil
+	return vals, nil
+}
+
+// GetValidators returns all the validators at the given height,
+// reusing the ones already fetched for the same height when possible
+func (m *Module) GetValidators(height int64) ([]stakingtypes.Validator, error) {
+	m.validatorsMutex.Lock()
+	validators, ok := m.validators[height]
+	m.validatorsMutex.Unlock()
+	if ok {
+		return validators, nil
+	}
+
+	validators, err := m.source.GetValidatorsWithStatus(height, "")
+	if err != nil {
+		return nil, err
+	}
+
+	m.validatorsMutex.Lock()
+	defer m.validatorsMutex.Unlock()
+
+	m.validators[height] = validators
+	for len(m.validators) > validatorsCacheSize {
+		delete(m.validators, getLowestHeight(m.validators))
+	}
+
+	return validators, nil
+}
+
+// getLowestHeight returns the lowest height among the given cached validators
+func getLowestHeight(validators map[int64][]stakingtypes.Validator) int64 {
+	var lowest int64
+	for height := range validators {
+		if lowest == 0 || height < lowest {
+			lowest = height
+		}
+	}
+	return lowest
 }
 
 // getValidators returns the validators list at the given height
 func (m *Module) getValidators(height int64) ([]stakingtypes.Validator, []types.Validator, error) {
-	return m.GetValidatorsWithStatus(height, "")
+	validators, err := m.GetValidators(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vals, err := m.convertValidators(height, validators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validators, vals, nil
 }
 
 // updateValidators updates the list of validators that are present at the given height
@@ -9,6 +9,7 @@ import (
 	"github.com/forbole/juno/v5/node/remote"
 	"github.com/forbole/juno/v5/types/params"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -20,6 +21,9 @@ import (
 
 	nodeconfig "github.com/forbole/juno/v5/node/config"
 
+	authsource "github.com/forbole/callisto/v4/modules/auth/source"
+	localauthsource "github.com/forbole/callisto/v4/modules/auth/source/local"
+	remoteauthsource "github.com/forbole/callisto/v4/modules/auth/source/remote"
 	banksource "github.com/forbole/callisto/v4/modules/bank/source"
 	localbanksource "github.com/forbole/callisto/v4/modules/bank/source/local"
 	remotebanksource "github.com/forbole/callisto/v4/modules/bank/source/remote"
@@ -40,6 +44,7 @@ import (
 )
 
 type Sources struct {
+	AuthSource     authsource.Source
 	BankSource     banksource.Source
 	DistrSource    distrsource.Source
 	GovSource      govsource.Source
@@ -51,7 +56,7 @@ type Sources struct {
 func BuildSources(nodeCfg nodeconfig.Config, encodingConfig params.EncodingConfig) (*Sources, error) {
 	switch cfg := nodeCfg.Details.(type) {
 	case *remote.Details:
-		return buildRemoteSources(cfg)
+		return buildRemoteSources(cfg, encodingConfig)
 	case *local.Details:
 		return buildLocalSources(cfg, encodingConfig)
 
@@ -71,6 +76,7 @@ func buildLocalSources(cfg *local.Details, encodingConfig params.EncodingConfig)
 	)
 
 	sources := &Sources{
+		AuthSource: localauthsource.NewSource(source, authtypes.QueryServer(app.AccountKeeper), encodingConfig.Codec),
 		BankSource: localbanksource.NewSource(source, banktypes.QueryServer(app.BankKeeper)),
 		// DistrSource:    localdistrsource.NewSource(source, distrtypes.QueryServer(app.DistrKeeper)),
 		GovSource:      localgovsource.NewSource(source, govtypesv1.QueryServer(app.GovKeeper)),
@@ -103,13 +109,14 @@ func buildLocalSources(cfg *local.Details, encodingConfig params.EncodingConfig)
 	return sources, nil
 }
 
-func buildRemoteSources(cfg *remote.Details) (*Sources, error) {
+func buildRemoteSources(cfg *remote.Details, encodingConfig params.EncodingConfig) (*Sources, error) {
 	source, err := remote.NewSource(cfg.GRPC)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating remote source: %s", err)
 	}
 
 	return &Sources{
+		AuthSource:     remoteauthsource.NewSource(source, authtypes.NewQueryClient(source.GrpcConn), encodingConfig.Codec),
 		BankSource:     remotebanksource.NewSource(source, banktypes.NewQueryClient(source.GrpcConn)),
 		DistrSource:    remotedistrsource.NewSource(source, distrtypes.NewQueryClient(source.GrpcConn)),
 		GovSource:      remotegovsource.NewSource(source, govtypesv1.NewQueryClient(source.GrpcConn)),
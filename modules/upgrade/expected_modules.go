@@ -1,5 +1,15 @@
 package upgrade
 
+import (
+	"github.com/forbole/juno/v5/modules"
+)
+
 type StakingModule interface {
 	RefreshAllValidatorInfos(height int64) error
 }
+
+// ParamsModule represents a module whose params should be refreshed once a software upgrade is applied
+type ParamsModule interface {
+	modules.Module
+	UpdateParams(height int64) error
+}
@@ -2,17 +2,22 @@ package upgrade
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/forbole/juno/v5/types"
 
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/forbole/callisto/v4/modules/utils"
 )
 
 // HandleBlock implements modules.Module
 func (m *Module) HandleBlock(
 	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ []*types.Tx, _ *tmctypes.ResultValidators,
-) error {
-	err := m.refreshDataUponSoftwareUpgrade(b.Block.Height)
+) (err error) {
+	defer utils.ObserveHandler(m.Name(), utils.HandlerBlock, time.Now(), &err)
+
+	err = m.RefreshDataUponSoftwareUpgrade(b.Block.Height, b.Block.Time)
 	if err != nil {
 		return fmt.Errorf("error while refreshing data upon software upgrade: %s", err)
 	}
@@ -20,7 +25,9 @@ func (m *Module) HandleBlock(
 	return nil
 }
 
-func (m *Module) refreshDataUponSoftwareUpgrade(height int64) error {
+// RefreshDataUponSoftwareUpgrade refreshes the validators and the params of all the modules if a software
+// upgrade plan is scheduled at the given height, marking it as applied at the given time
+func (m *Module) RefreshDataUponSoftwareUpgrade(height int64, timestamp time.Time) error {
 	exist, err := m.db.CheckSoftwareUpgradePlan(height)
 	if err != nil {
 		return fmt.Errorf("error while checking software upgrade plan existence: %s", err)
@@ -35,6 +42,20 @@ func (m *Module) refreshDataUponSoftwareUpgrade(height int64) error {
 		return fmt.Errorf("error while refreshing validator infos upon software upgrade: %s", err)
 	}
 
+	// Refresh the params of all the modules, since upgrades can change them without any message
+	for _, module := range m.paramsModules {
+		err = module.UpdateParams(height)
+		if err != nil {
+			return fmt.Errorf("error while refreshing %s params upon software upgrade: %s", module.Name(), err)
+		}
+	}
+
+	// Keep track of the upgrade inside the history before deleting the plan
+	err = m.db.SaveAppliedUpgrade(height, timestamp)
+	if err != nil {
+		return fmt.Errorf("error while storing applied software upgrade: %s", err)
+	}
+
 	// Delete plan after refreshing data
 	err = m.db.TruncateSoftwareUpgradePlan(height)
 	if err != nil {
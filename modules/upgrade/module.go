@@ -15,12 +15,14 @@ var (
 type Module struct {
 	db            *database.Db
 	stakingModule StakingModule
+	paramsModules []ParamsModule
 }
 
 // NewModule builds a new Module instance
-func NewModule(db *database.Db, stakingModule StakingModule) *Module {
+func NewModule(db *database.Db, stakingModule StakingModule, paramsModules []ParamsModule) *Module {
 	return &Module{
 		stakingModule: stakingModule,
+		paramsModules: paramsModules,
 		db:            db,
 	}
 }
@@ -1,16 +1,61 @@
 package utils
 
 import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/rs/zerolog/log"
 )
 
+// runningJobs contains the names of the jobs started using WatchMethodSingleton that are still running
+var runningJobs sync.Map
+
 // WatchMethod allows to watch for a method that returns an error.
 // It executes the given method in a goroutine, logging any error that might raise.
+// The time of the last successful run and the number of failed runs are tracked using the method name
 func WatchMethod(method func() error) {
+	job := GetJobName(method)
+	go runMethod(job, method)
+}
+
+// WatchMethodSingleton works like WatchMethod, but it does not execute the given method
+// if a previous execution started using this function is still running
+func WatchMethodSingleton(method func() error) {
+	job := GetJobName(method)
+	if _, running := runningJobs.LoadOrStore(job, true); running {
+		log.Debug().Str("job", job).Msg("previous run still in progress, skipping")
+		return
+	}
+
 	go func() {
-		err := method()
-		if err != nil {
-			log.Error().Err(err).Send()
-		}
+		defer runningJobs.Delete(job)
+		runMethod(job, method)
 	}()
 }
+
+// runMethod executes the given method, tracking its outcome using the given job name
+func runMethod(job string, method func() error) {
+	err := method()
+	if err != nil {
+		JobErrorsCounter.WithLabelValues(job).Inc()
+		log.Error().Str("job", job).Err(err).Send()
+		return
+	}
+
+	JobLastSuccessGauge.WithLabelValues(job).Set(float64(time.Now().Unix()))
+}
+
+// GetJobName returns the name of the given method in the <package>.<method> form,
+// eg. "staking.UpdateStakingPool" for the UpdateStakingPool method of the staking module
+func GetJobName(method interface{}) string {
+	name := runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name()
+
+	// Remove the package path and the receiver type
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = strings.TrimSuffix(name, "-fm")
+	name = strings.Replace(name, ".(*Module)", "", 1)
+	return name
+}
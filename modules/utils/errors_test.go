@@ -0,0 +1,54 @@
+package utils_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/callisto/v4/modules/utils"
+)
+
+type Module struct{}
+
+func (m *Module) UpdateData() error {
+	return nil
+}
+
+func TestGetJobName(t *testing.T) {
+	m := &Module{}
+	require.Equal(t, "utils_test.UpdateData", utils.GetJobName(m.UpdateData))
+}
+
+type singletonModule struct {
+	runs    atomic.Int32
+	release chan struct{}
+}
+
+func (m *singletonModule) Run() error {
+	m.runs.Add(1)
+	<-m.release
+	return nil
+}
+
+func TestWatchMethodSingleton(t *testing.T) {
+	m := &singletonModule{release: make(chan struct{})}
+
+	require.Eventually(t, func() bool {
+		utils.WatchMethodSingleton(m.Run)
+		return m.runs.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// The previous run is still in progress, so the method is not executed again
+	utils.WatchMethodSingleton(m.Run)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, int32(1), m.runs.Load())
+	close(m.release)
+
+	// Once the previous run has completed, the method can be executed again
+	require.Eventually(t, func() bool {
+		utils.WatchMethodSingleton(m.Run)
+		return m.runs.Load() >= 2
+	}, time.Second, 10*time.Millisecond)
+}
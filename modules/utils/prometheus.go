@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	HandlerBlock = "block"
+	HandlerTx    = "tx"
+	HandlerMsg   = "msg"
+)
+
+// HandlerDuration represents the Telemetry histogram used to track the time taken by each module to handle
+// blocks, transactions and messages
+var HandlerDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "callisto_module_handler_duration_seconds",
+		Help:    "Time taken by each module to handle a block, a transaction or a message.",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	},
+	[]string{"module", "handler"},
+)
+
+// HandlerErrorsCounter represents the Telemetry counter used to track the number of errors returned by each module
+// while handling blocks, transactions and messages
+var HandlerErrorsCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "callisto_module_handler_errors_total",
+		Help: "Total number of errors returned by each module while handling a block, a transaction or a message.",
+	},
+	[]string{"module", "handler"},
+)
+
+// JobLastSuccessGauge represents the Telemetry gauge used to track the last time each periodic job has succeeded
+var JobLastSuccessGauge = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "callisto_periodic_job_last_success_timestamp_seconds",
+		Help: "Unix timestamp of the last successful run of each periodic job.",
+	},
+	[]string{"job"},
+)
+
+// JobErrorsCounter represents the Telemetry counter used to track the number of failed runs of each periodic job
+var JobErrorsCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "callisto_periodic_job_errors_total",
+		Help: "Total number of failed runs of each periodic job.",
+	},
+	[]string{"job"},
+)
+
+// ExternalAPIDuration represents the Telemetry histogram used to track the latency of the calls
+// to the external APIs, such as CoinGecko and Keybase
+var ExternalAPIDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "callisto_external_api_request_duration_seconds",
+		Help:    "Time taken by the requests to the external APIs.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	},
+	[]string{"api", "endpoint", "status"},
+)
+
+func init() {
+	for _, collector := range []prometheus.Collector{
+		HandlerDuration,
+		HandlerErrorsCounter,
+		JobLastSuccessGauge,
+		JobErrorsCounter,
+		ExternalAPIDuration,
+	} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ObserveHandler records the time taken by the given module to run the given handler since the given start time,
+// counting the error pointed by err if any. It is meant to be deferred using a named error result
+func ObserveHandler(module string, handler string, start time.Time, err *error) {
+	HandlerDuration.WithLabelValues(module, handler).Observe(time.Since(start).Seconds())
+	if err != nil && *err != nil {
+		HandlerErrorsCounter.WithLabelValues(module, handler).Inc()
+	}
+}
+
+// ObserveExternalAPI records the time taken by the request to the given endpoint of the given external API
+// since the given start time, labelling it based on the returned error
+func ObserveExternalAPI(api string, endpoint string, start time.Time, err error) {
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	ExternalAPIDuration.WithLabelValues(api, endpoint, status).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ActivityPeriodHour identifies the chain activity buckets spanning one hour
+	ActivityPeriodHour = "hour"
+
+	// ActivityPeriodDay identifies the chain activity buckets spanning one day
+	ActivityPeriodDay = "day"
+)
+
+// ActivityPeriods contains all the periods of the chain activity buckets
+var ActivityPeriods = []string{
+	ActivityPeriodHour,
+	ActivityPeriodDay,
+}
+
+// BlockActivity contains the chain activity of a single block, which is added to the
+// hourly and daily chain activity buckets containing the block timestamp
+type BlockActivity struct {
+	TxsCount      int64
+	SuccessfulTxs int64
+	FailedTxs     int64
+	Signers       []string
+	NewAccounts   int64
+	Fees          sdk.Coins
+	GasUsed       int64
+	GasWanted     int64
+	Height        int64
+	Timestamp     time.Time
+}
+
+// NewBlockActivity allows to build a new BlockActivity instance
+func NewBlockActivity(
+	txsCount, successfulTxs, failedTxs int64, signers []string, newAccounts int64, fees sdk.Coins,
+	gasUsed, gasWanted int64, height int64, timestamp time.Time,
+) BlockActivity {
+	return BlockActivity{
+		TxsCount:      txsCount,
+		SuccessfulTxs: successfulTxs,
+		FailedTxs:     failedTxs,
+		Signers:       signers,
+		NewAccounts:   newAccounts,
+		Fees:          fees,
+		GasUsed:       gasUsed,
+		GasWanted:     gasWanted,
+		Height:        height,
+		Timestamp:     timestamp,
+	}
+}
+
+// FeeMarket contains the gas usage statistics of the transactions included inside a block
+type FeeMarket struct {
+	TxsCount      int64
+	GasUsed       int64
+	GasWanted     int64
+	GasEfficiency sdk.Dec
+	OutOfGasTxs   int64
+	Height        int64
+	Timestamp     time.Time
+}
+
+// NewFeeMarket allows to build a new FeeMarket instance
+func NewFeeMarket(
+	txsCount, gasUsed, gasWanted int64, gasEfficiency sdk.Dec, outOfGasTxs int64, height int64, timestamp time.Time,
+) FeeMarket {
+	return FeeMarket{
+		TxsCount:      txsCount,
+		GasUsed:       gasUsed,
+		GasWanted:     gasWanted,
+		GasEfficiency: gasEfficiency,
+		OutOfGasTxs:   outOfGasTxs,
+		Height:        height,
+		Timestamp:     timestamp,
+	}
+}
+
+// GasPrice contains the statistics of the effective gas prices paid using the fees of a single denom
+type GasPrice struct {
+	Denom          string
+	TxsCount       int64
+	MinGasPrice    sdk.Dec
+	MedianGasPrice sdk.Dec
+	P90GasPrice    sdk.Dec
+}
+
+// NewGasPrice allows to build a new GasPrice instance
+func NewGasPrice(denom string, txsCount int64, minGasPrice, medianGasPrice, p90GasPrice sdk.Dec) GasPrice {
+	return GasPrice{
+		Denom:          denom,
+		TxsCount:       txsCount,
+		MinGasPrice:    minGasPrice,
+		MedianGasPrice: medianGasPrice,
+		P90GasPrice:    p90GasPrice,
+	}
+}
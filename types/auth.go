@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Account represents a chain account
 type Account struct {
 	Address string
@@ -11,3 +17,61 @@ func NewAccount(address string) Account {
 		Address: address,
 	}
 }
+
+// VestingUnlock represents an amount of coins of a vesting account that gets unlocked linearly between
+// the start and end time. Unlocks that happen all at once have the same start and end time
+type VestingUnlock struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Amount    sdk.Coins
+}
+
+// NewVestingUnlock allows to build a new VestingUnlock instance
+func NewVestingUnlock(startTime, endTime time.Time, amount sdk.Coins) VestingUnlock {
+	return VestingUnlock{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Amount:    amount,
+	}
+}
+
+// VestingAmounts contains the amounts of a vesting account at the given time
+type VestingAmounts struct {
+	Timestamp       time.Time
+	OriginalVesting sdk.Coins
+	Vested          sdk.Coins
+	Locked          sdk.Coins
+	Spendable       sdk.Coins
+}
+
+// NewVestingAmounts allows to build a new VestingAmounts instance
+func NewVestingAmounts(timestamp time.Time, originalVesting, vested, locked, spendable sdk.Coins) VestingAmounts {
+	return VestingAmounts{
+		Timestamp:       timestamp,
+		OriginalVesting: originalVesting,
+		Vested:          vested,
+		Locked:          locked,
+		Spendable:       spendable,
+	}
+}
+
+// VestingUnlockCalendarEntry represents the total amount that all the vesting accounts unlock during a single day,
+// computed at the given height
+type VestingUnlockCalendarEntry struct {
+	Date          time.Time
+	Amount        sdk.Coins
+	AccountsCount int
+	Height        int64
+}
+
+// NewVestingUnlockCalendarEntry allows to build a new VestingUnlockCalendarEntry instance
+func NewVestingUnlockCalendarEntry(
+	date time.Time, amount sdk.Coins, accountsCount int, height int64,
+) VestingUnlockCalendarEntry {
+	return VestingUnlockCalendarEntry{
+		Date:          date,
+		Amount:        amount,
+		AccountsCount: accountsCount,
+		Height:        height,
+	}
+}
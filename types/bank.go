@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
 
 // AccountBalance represents the balance of an account at a given height
 type AccountBalance struct {
@@ -17,3 +20,17 @@ func NewAccountBalance(address string, balance sdk.Coins, height int64) AccountB
 		Height:  height,
 	}
 }
+
+// BankParams represents the parameters of the x/bank module at a given height
+type BankParams struct {
+	banktypes.Params
+	Height int64
+}
+
+// NewBankParams allows to build a new BankParams instance
+func NewBankParams(params banktypes.Params, height int64) *BankParams {
+	return &BankParams{
+		Params: params,
+		Height: height,
+	}
+}
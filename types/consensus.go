@@ -1,6 +1,10 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Genesis contains the useful information about the genesis
 type Genesis struct {
@@ -49,3 +53,101 @@ func (c ConsensusEvent) Equal(other ConsensusEvent) bool {
 		c.Round == other.Round &&
 		c.Step == other.Step
 }
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// ProposerBlockStats contains the contribution of a single block to the daily block production
+// statistics of a validator
+type ProposerBlockStats struct {
+	ValidatorAddress  string
+	Date              time.Time
+	BlocksProposed    int64
+	ExpectedProposals sdk.Dec
+	EmptyBlocks       int64
+	TotalGas          int64
+	Height            int64
+}
+
+// NewProposerBlockStats allows to build a new ProposerBlockStats instance
+func NewProposerBlockStats(
+	validatorAddress string, date time.Time, blocksProposed int64, expectedProposals sdk.Dec,
+	emptyBlocks int64, totalGas int64, height int64,
+) ProposerBlockStats {
+	return ProposerBlockStats{
+		ValidatorAddress:  validatorAddress,
+		Date:              date,
+		BlocksProposed:    blocksProposed,
+		ExpectedProposals: expectedProposals,
+		EmptyBlocks:       emptyBlocks,
+		TotalGas:          totalGas,
+		Height:            height,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+const (
+	// ValidatorSetChangeEntered identifies a validator that has entered the active validator set
+	ValidatorSetChangeEntered = "entered"
+
+	// ValidatorSetChangeLeft identifies a validator that has left the active validator set
+	ValidatorSetChangeLeft = "left"
+
+	// ValidatorSetChangeVotingPower identifies a validator of the active set whose voting power has changed
+	ValidatorSetChangeVotingPower = "voting_power_changed"
+)
+
+// ValidatorSetChange represents a change of the membership or of the voting power of a validator
+// inside the active validator set
+type ValidatorSetChange struct {
+	ValidatorAddress    string
+	Type                string
+	PreviousVotingPower int64
+	VotingPower         int64
+	Status              int
+	Jailed              bool
+	Height              int64
+	Timestamp           time.Time
+}
+
+// NewValidatorSetChange allows to build a new ValidatorSetChange instance
+func NewValidatorSetChange(
+	validatorAddress string, changeType string, previousVotingPower, votingPower int64,
+	status int, jailed bool, height int64, timestamp time.Time,
+) ValidatorSetChange {
+	return ValidatorSetChange{
+		ValidatorAddress:    validatorAddress,
+		Type:                changeType,
+		PreviousVotingPower: previousVotingPower,
+		VotingPower:         votingPower,
+		Status:              status,
+		Jailed:              jailed,
+		Height:              height,
+		Timestamp:           timestamp,
+	}
+}
+
+// VotingPowerDelta returns the difference between the new and the previous voting power of the validator
+func (c ValidatorSetChange) VotingPowerDelta() int64 {
+	return c.VotingPower - c.PreviousVotingPower
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+const (
+	// BlockTimePeriodMinute identifies the block time buckets spanning one minute
+	BlockTimePeriodMinute = "minute"
+
+	// BlockTimePeriodHour identifies the block time buckets spanning one hour
+	BlockTimePeriodHour = "hour"
+
+	// BlockTimePeriodDay identifies the block time buckets spanning one day
+	BlockTimePeriodDay = "day"
+)
+
+// BlockTimePeriods contains all the periods of the block time series
+var BlockTimePeriods = []string{
+	BlockTimePeriodMinute,
+	BlockTimePeriodHour,
+	BlockTimePeriodDay,
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -17,3 +21,93 @@ func NewDistributionParams(params distrtypes.Params, height int64) *Distribution
 		Height: height,
 	}
 }
+
+// DelegationRewardWithdrawal represents the rewards that a delegator has withdrawn from a validator
+// while executing the message having the given index inside the given transaction
+type DelegationRewardWithdrawal struct {
+	TxHash           string
+	MsgIndex         int
+	DelegatorAddress string
+	ValidatorAddress string
+	Amount           sdk.Coins
+	Height           int64
+	Timestamp        time.Time
+}
+
+// NewDelegationRewardWithdrawal allows to build a new DelegationRewardWithdrawal instance
+func NewDelegationRewardWithdrawal(
+	txHash string, msgIndex int, delegatorAddress, validatorAddress string, amount sdk.Coins,
+	height int64, timestamp time.Time,
+) DelegationRewardWithdrawal {
+	return DelegationRewardWithdrawal{
+		TxHash:           txHash,
+		MsgIndex:         msgIndex,
+		DelegatorAddress: delegatorAddress,
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+		Height:           height,
+		Timestamp:        timestamp,
+	}
+}
+
+// StakingAPR represents the nominal yield of the whole network at the given height
+type StakingAPR struct {
+	AnnualProvisions sdk.Dec
+	CommunityTax     sdk.Dec
+	BondedTokens     sdkmath.Int
+	APR              sdk.Dec
+	APY              sdk.Dec
+	Height           int64
+	Timestamp        time.Time
+}
+
+// NewStakingAPR allows to build a new StakingAPR instance
+func NewStakingAPR(
+	annualProvisions, communityTax sdk.Dec, bondedTokens sdkmath.Int, apr, apy sdk.Dec,
+	height int64, timestamp time.Time,
+) StakingAPR {
+	return StakingAPR{
+		AnnualProvisions: annualProvisions,
+		CommunityTax:     communityTax,
+		BondedTokens:     bondedTokens,
+		APR:              apr,
+		APY:              apy,
+		Height:           height,
+		Timestamp:        timestamp,
+	}
+}
+
+// ValidatorAPR represents the yield that delegators of a validator get at the given height.
+// APR and APY are computed from the network nominal APR after removing the validator commission, while the
+// realized APRs are computed from the rewards that have actually been withdrawn during the trailing windows.
+// Realized APRs are nil when the validator has no bonded tokens
+type ValidatorAPR struct {
+	ValidatorAddress string
+	Commission       sdk.Dec
+	APR              sdk.Dec
+	APY              sdk.Dec
+	RealizedAPR7d    *sdk.Dec
+	RealizedAPR30d   *sdk.Dec
+	RealizedAPR90d   *sdk.Dec
+	Height           int64
+	Timestamp        time.Time
+}
+
+// NewValidatorAPR allows to build a new ValidatorAPR instance
+func NewValidatorAPR(
+	validatorAddress string, commission, apr, apy sdk.Dec,
+	realizedAPR7d, realizedAPR30d, realizedAPR90d *sdk.Dec,
+	height int64, timestamp time.Time,
+) ValidatorAPR {
+	return ValidatorAPR{
+		ValidatorAddress: validatorAddress,
+		Commission:       commission,
+		APR:              apr,
+		APY:              apy,
+		RealizedAPR7d:    realizedAPR7d,
+		RealizedAPR30d:   realizedAPR30d,
+		RealizedAPR90d:   realizedAPR90d,
+		Height:           height,
+		Timestamp:        timestamp,
+	}
+}
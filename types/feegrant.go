@@ -1,6 +1,26 @@
 package types
 
-import feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+const (
+	// FeeGrantStatusActive represents a fee grant allowance that can still be used
+	FeeGrantStatusActive = "active"
+
+	// FeeGrantStatusRevoked represents a fee grant allowance that has been revoked by its granter
+	FeeGrantStatusRevoked = "revoked"
+
+	// FeeGrantStatusExpired represents a fee grant allowance whose expiration time has passed
+	FeeGrantStatusExpired = "expired"
+
+	// FeeGrantStatusExhausted represents a fee grant allowance whose whole spend limit has been used
+	FeeGrantStatusExhausted = "exhausted"
+)
 
 // FeeGrant represents the x/feegrant module
 type FeeGrant struct {
@@ -30,3 +50,98 @@ func NewGrantRemoval(grantee string, granter string, height int64) GrantRemoval
 		height,
 	}
 }
+
+// FeeGrantLimits contains the limits of a fee grant allowance.
+// A nil SpendLimit means that the allowance can spend an unlimited amount of tokens
+type FeeGrantLimits struct {
+	SpendLimit       sdk.Coins
+	PeriodSpendLimit sdk.Coins
+	PeriodCanSpend   sdk.Coins
+	PeriodReset      *time.Time
+	Expiration       *time.Time
+	AllowedMessages  []string
+}
+
+// NewFeeGrantLimits returns the limits of the given allowance, unwrapping any AllowedMsgAllowance
+func NewFeeGrantLimits(allowance feegranttypes.FeeAllowanceI) (FeeGrantLimits, error) {
+	switch allowance := allowance.(type) {
+	case *feegranttypes.BasicAllowance:
+		return FeeGrantLimits{
+			SpendLimit: allowance.SpendLimit,
+			Expiration: allowance.Expiration,
+		}, nil
+
+	case *feegranttypes.PeriodicAllowance:
+		periodReset := allowance.PeriodReset
+		return FeeGrantLimits{
+			SpendLimit:       allowance.Basic.SpendLimit,
+			PeriodSpendLimit: allowance.PeriodSpendLimit,
+			PeriodCanSpend:   allowance.PeriodCanSpend,
+			PeriodReset:      &periodReset,
+			Expiration:       allowance.Basic.Expiration,
+		}, nil
+
+	case *feegranttypes.AllowedMsgAllowance:
+		inner, err := allowance.GetAllowance()
+		if err != nil {
+			return FeeGrantLimits{}, err
+		}
+
+		limits, err := NewFeeGrantLimits(inner)
+		if err != nil {
+			return FeeGrantLimits{}, err
+		}
+
+		limits.AllowedMessages = allowance.AllowedMessages
+		return limits, nil
+
+	default:
+		return FeeGrantLimits{}, fmt.Errorf("unsupported fee allowance type: %T", allowance)
+	}
+}
+
+// FeeGrantUsage represents a fee paid by a granter on behalf of a grantee
+type FeeGrantUsage struct {
+	TxHash    string
+	Grantee   string
+	Granter   string
+	Fee       sdk.Coins
+	Height    int64
+	Timestamp time.Time
+}
+
+// NewFeeGrantUsage allows to build a new FeeGrantUsage instance
+func NewFeeGrantUsage(
+	txHash string, grantee string, granter string, fee sdk.Coins, height int64, timestamp time.Time,
+) FeeGrantUsage {
+	return FeeGrantUsage{
+		TxHash:    txHash,
+		Grantee:   grantee,
+		Granter:   granter,
+		Fee:       fee,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
+
+// FeeGrantAllowanceUpdate contains the state of a stored fee grant allowance after a fee has been paid using it
+type FeeGrantAllowanceUpdate struct {
+	ID        uint64
+	Allowance feegranttypes.FeeAllowanceI
+	Spent     sdk.Coins
+	Status    string
+	Height    int64
+}
+
+// NewFeeGrantAllowanceUpdate allows to build a new FeeGrantAllowanceUpdate instance
+func NewFeeGrantAllowanceUpdate(
+	id uint64, allowance feegranttypes.FeeAllowanceI, spent sdk.Coins, status string, height int64,
+) FeeGrantAllowanceUpdate {
+	return FeeGrantAllowanceUpdate{
+		ID:        id,
+		Allowance: allowance,
+		Spent:     spent,
+		Status:    status,
+		Height:    height,
+	}
+}
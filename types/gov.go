@@ -186,6 +186,50 @@ func NewTallyResult(
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// ProposalTallyProjection contains the outcome that a proposal would have if its voting period ended
+// at the given height
+type ProposalTallyProjection struct {
+	ProposalID      uint64
+	Turnout         sdk.Dec
+	Quorum          sdk.Dec
+	QuorumReached   bool
+	YesRatio        sdk.Dec
+	Threshold       sdk.Dec
+	VetoRatio       sdk.Dec
+	VetoThreshold   sdk.Dec
+	ProjectedStatus string
+	Height          int64
+}
+
+// NewProposalTallyProjection returns a new ProposalTallyProjection instance
+func NewProposalTallyProjection(
+	proposalID uint64,
+	turnout sdk.Dec,
+	quorum sdk.Dec,
+	quorumReached bool,
+	yesRatio sdk.Dec,
+	threshold sdk.Dec,
+	vetoRatio sdk.Dec,
+	vetoThreshold sdk.Dec,
+	projectedStatus string,
+	height int64,
+) ProposalTallyProjection {
+	return ProposalTallyProjection{
+		ProposalID:      proposalID,
+		Turnout:         turnout,
+		Quorum:          quorum,
+		QuorumReached:   quorumReached,
+		YesRatio:        yesRatio,
+		Threshold:       threshold,
+		VetoRatio:       vetoRatio,
+		VetoThreshold:   vetoThreshold,
+		ProjectedStatus: projectedStatus,
+		Height:          height,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // ProposalStakingPoolSnapshot contains the data about a single staking pool snapshot to be associated with a proposal
 type ProposalStakingPoolSnapshot struct {
 	ProposalID uint64
@@ -17,3 +17,19 @@ func NewMessageType(msgType string, module string, label string, height int64) *
 		Height: height,
 	}
 }
+
+// MessageTypeBlockStats contains the usage of a single message type inside a block
+type MessageTypeBlockStats struct {
+	Type          string
+	MessagesCount int64
+	Senders       []string
+}
+
+// NewMessageTypeBlockStats allows to build a new MessageTypeBlockStats instance
+func NewMessageTypeBlockStats(msgType string, messagesCount int64, senders []string) MessageTypeBlockStats {
+	return MessageTypeBlockStats{
+		Type:          msgType,
+		MessagesCount: messagesCount,
+		Senders:       senders,
+	}
+}
@@ -1,6 +1,11 @@
 package types
 
-import minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
 
 // MintParams represents the x/mint parameters
 type MintParams struct {
@@ -15,3 +20,34 @@ func NewMintParams(params minttypes.Params, height int64) *MintParams {
 		Height: height,
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SupplyProjection represents the projected supply of the mint denom after the given number of days,
+// computed at the given height
+type SupplyProjection struct {
+	Day              int
+	Timestamp        time.Time
+	ProjectedHeight  int64
+	Inflation        sdk.Dec
+	AnnualProvisions sdk.Dec
+	Supply           sdk.Dec
+	Height           int64
+}
+
+// NewSupplyProjection allows to build a new SupplyProjection instance
+func NewSupplyProjection(
+	day int, timestamp time.Time, projectedHeight int64,
+	inflation sdk.Dec, annualProvisions sdk.Dec, supply sdk.Dec,
+	height int64,
+) SupplyProjection {
+	return SupplyProjection{
+		Day:              day,
+		Timestamp:        timestamp,
+		ProjectedHeight:  projectedHeight,
+		Inflation:        inflation,
+		AnnualProvisions: annualProvisions,
+		Supply:           supply,
+		Height:           height,
+	}
+}
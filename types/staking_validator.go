@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -162,3 +164,40 @@ func NewValidatorStatus(valConsAddr, pubKey string, status int, jailed bool, hei
 }
 
 //---------------------------------------------------------------
+
+// DecentralizationMetrics contains the metrics describing how the voting power is distributed
+// among the active validators at the given height
+type DecentralizationMetrics struct {
+	ValidatorsCount       int
+	TotalVotingPower      int64
+	NakamotoCoefficient33 int
+	NakamotoCoefficient66 int
+	GiniCoefficient       sdk.Dec
+	HerfindahlIndex       sdk.Dec
+	Top5Share             sdk.Dec
+	Top10Share            sdk.Dec
+	Top20Share            sdk.Dec
+	Height                int64
+	Timestamp             time.Time
+}
+
+// NewDecentralizationMetrics allows to build a new DecentralizationMetrics instance
+func NewDecentralizationMetrics(
+	validatorsCount int, totalVotingPower int64, nakamotoCoefficient33, nakamotoCoefficient66 int,
+	giniCoefficient, herfindahlIndex, top5Share, top10Share, top20Share sdk.Dec,
+	height int64, timestamp time.Time,
+) DecentralizationMetrics {
+	return DecentralizationMetrics{
+		ValidatorsCount:       validatorsCount,
+		TotalVotingPower:      totalVotingPower,
+		NakamotoCoefficient33: nakamotoCoefficient33,
+		NakamotoCoefficient66: nakamotoCoefficient66,
+		GiniCoefficient:       giniCoefficient,
+		HerfindahlIndex:       herfindahlIndex,
+		Top5Share:             top5Share,
+		Top10Share:            top10Share,
+		Top20Share:            top20Share,
+		Height:                height,
+		Timestamp:             timestamp,
+	}
+}
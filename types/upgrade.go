@@ -0,0 +1,12 @@
+package types
+
+const (
+	// UpgradeStatusScheduled identifies an upgrade plan that is waiting for its height to be reached
+	UpgradeStatusScheduled = "scheduled"
+
+	// UpgradeStatusCancelled identifies an upgrade plan that has been cancelled or replaced by a newer one
+	UpgradeStatusCancelled = "cancelled"
+
+	// UpgradeStatusApplied identifies an upgrade plan that has been applied once its height was reached
+	UpgradeStatusApplied = "applied"
+)